@@ -10,6 +10,10 @@ import (
 // will panic if no inner testing.T set.
 type Assertion struct {
 	*testing.T
+
+	// recorder records the failures of the assertions instead of reporting them to the test if the
+	// assertion is created for an assertion block.
+	recorder *assertionBlockRecorder
 }
 
 // New returns an assertion instance for verifying invariants.
@@ -28,6 +32,16 @@ func New(t *testing.T) *Assertion {
 	return a
 }
 
+// testingT returns the recorder of the assertion block if the assertion is created for an
+// assertion block, or the testing.T of the assertion to report the failures.
+func (a *Assertion) testingT() testingT {
+	if a.recorder != nil {
+		return a.recorder
+	}
+
+	return a.T
+}
+
 // Run runs f as a subtest of a called name. It runs f in a separate goroutine
 // and blocks until f returns or calls a.Parallel to become a parallel test.
 // Run reports whether f succeeded (or at least did not fail before calling t.Parallel).
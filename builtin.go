@@ -1,9 +1,16 @@
 package assert
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"reflect"
 	"regexp"
+	"strings"
 	"testing"
+	"time"
 )
 
 // NotContainsElement tests whether the array or slice contains the specified element or not, and
@@ -103,7 +110,7 @@ func Gt(t *testing.T, v1, v2 any, message ...string) error {
 		false,
 		compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGt, v1, v2),
+		fmt.Sprintf(defaultErrMessageGt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -124,7 +131,7 @@ func GtNow(t *testing.T, v1, v2 any, message ...string) error {
 		true,
 		compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGt, v1, v2),
+		fmt.Sprintf(defaultErrMessageGt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -145,7 +152,7 @@ func Gte(t *testing.T, v1, v2 any, message ...string) error {
 		false,
 		compareTypeEqual|compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGte, v1, v2),
+		fmt.Sprintf(defaultErrMessageGte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -167,7 +174,7 @@ func GteNow(t *testing.T, v1, v2 any, message ...string) error {
 		true,
 		compareTypeEqual|compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGte, v1, v2),
+		fmt.Sprintf(defaultErrMessageGte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -188,7 +195,7 @@ func Lt(t *testing.T, v1, v2 any, message ...string) error {
 		false,
 		compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLt, v1, v2),
+		fmt.Sprintf(defaultErrMessageLt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -209,7 +216,7 @@ func LtNow(t *testing.T, v1, v2 any, message ...string) error {
 		true,
 		compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLt, v1, v2),
+		fmt.Sprintf(defaultErrMessageLt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -230,7 +237,7 @@ func Lte(t *testing.T, v1, v2 any, message ...string) error {
 		false,
 		compareTypeEqual|compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLte, v1, v2),
+		fmt.Sprintf(defaultErrMessageLte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -252,7 +259,7 @@ func LteNow(t *testing.T, v1, v2 any, message ...string) error {
 		true,
 		compareTypeEqual|compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLte, v1, v2),
+		fmt.Sprintf(defaultErrMessageLte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -1072,3 +1079,2811 @@ func NotTrueNow(t *testing.T, val any, message ...any) error {
 
 	return tryNotTrue(t, true, val, message...)
 }
+
+// ContainsFunc tests whether the array, slice, or the values of the map contain at least one
+// element that satisfies the predicate, and it set the result to fail if no element satisfies the
+// predicate. The predicate can be a function like `func(e T) bool`, or an assertion block like
+// `func(a *assert.Assertion, e T)` that is satisfied if no assertion in the block failed.
+//
+//	assert.ContainsFunc(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	assert.ContainsFunc(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail
+func ContainsFunc(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAnyMatch(t, false, source, fn, message...)
+}
+
+// ContainsFuncNow tests whether the array, slice, or the values of the map contain at least one
+// element that satisfies the predicate, and it will terminate the execution if no element
+// satisfies the predicate.
+//
+//	assert.ContainsFuncNow(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	assert.ContainsFuncNow(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail and terminate
+//	// never runs
+func ContainsFuncNow(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAnyMatch(t, true, source, fn, message...)
+}
+
+// AllMatch tests whether every element of the array, slice, or the values of the map satisfies
+// the predicate, and it set the result to fail if any element does not satisfy the predicate. The
+// indices (or the keys for a map) of the mismatched elements will be reported in the message.
+//
+//	assert.AllMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 0 }) // success
+//	assert.AllMatch(t, []int{1, 2, 3}, func(a *assert.Assertion, n int) {
+//	  a.Gt(n, 0)
+//	}) // success
+//	assert.AllMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }) // fail
+func AllMatch(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAllMatch(t, false, source, fn, message...)
+}
+
+// AllMatchNow tests whether every element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if any element does not satisfy the
+// predicate.
+//
+//	assert.AllMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 0 }) // success
+//	assert.AllMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }) // fail and terminate
+//	// never runs
+func AllMatchNow(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAllMatch(t, true, source, fn, message...)
+}
+
+// AnyMatch tests whether any element of the array, slice, or the values of the map satisfies the
+// predicate, and it set the result to fail if no element satisfies the predicate.
+//
+//	assert.AnyMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	assert.AnyMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail
+func AnyMatch(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAnyMatch(t, false, source, fn, message...)
+}
+
+// AnyMatchNow tests whether any element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if no element satisfies the predicate.
+//
+//	assert.AnyMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	assert.AnyMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail and terminate
+//	// never runs
+func AnyMatchNow(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryAnyMatch(t, true, source, fn, message...)
+}
+
+// NoneMatch tests whether no element of the array, slice, or the values of the map satisfies the
+// predicate, and it set the result to fail if any element satisfies the predicate. The indices (or
+// the keys for a map) of the matched elements will be reported in the message.
+//
+//	assert.NoneMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // success
+//	assert.NoneMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // fail
+func NoneMatch(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryNoneMatch(t, false, source, fn, message...)
+}
+
+// NoneMatchNow tests whether no element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if any element satisfies the predicate.
+//
+//	assert.NoneMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 3 }) // success
+//	assert.NoneMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 2 }) // fail and terminate
+//	// never runs
+func NoneMatchNow(t *testing.T, source, fn any, message ...any) error {
+	t.Helper()
+
+	return tryNoneMatch(t, true, source, fn, message...)
+}
+
+// CountMatch tests whether the number of elements of the array, slice, or the values of the map
+// that satisfy the predicate equals to n, and it set the result to fail if the number is not n.
+//
+//	assert.CountMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }, 2) // success
+//	assert.CountMatch(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }, 1) // fail
+func CountMatch(t *testing.T, source, fn any, n int, message ...any) error {
+	t.Helper()
+
+	return tryCountMatch(t, false, source, fn, n, message...)
+}
+
+// CountMatchNow tests whether the number of elements of the array, slice, or the values of the
+// map that satisfy the predicate equals to n, and it will terminate the execution if the number is
+// not n.
+//
+//	assert.CountMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }, 2) // success
+//	assert.CountMatchNow(t, []int{1, 2, 3}, func(n int) bool { return n > 1 }, 1) // fail and terminate
+//	// never runs
+func CountMatchNow(t *testing.T, source, fn any, n int, message ...any) error {
+	t.Helper()
+
+	return tryCountMatch(t, true, source, fn, n, message...)
+}
+
+// Between tests whether the value is between the lower bound and the upper bound (both
+// inclusive), and it set the result to fail if the value is not in the range. It supports all
+// values that can be compared by the comparison assertions like Gt and Lt, and it'll panic if the
+// values are not the same type or not orderable.
+//
+//	assert.Between(t, 42, 0, 100) // success
+//	assert.Between(t, 40, 0, 40) // success
+//	assert.Between(t, 42, 0, 40) // fail: 42 not in [0, 40]
+func Between(t *testing.T, v, lo, hi any, message ...any) error {
+	t.Helper()
+
+	return tryBetween(t, false, v, lo, hi, compareTypeEqual, message...)
+}
+
+// BetweenNow tests whether the value is between the lower bound and the upper bound (both
+// inclusive), and it will terminate the execution if the value is not in the range.
+//
+//	assert.BetweenNow(t, 42, 0, 100) // success
+//	assert.BetweenNow(t, 42, 0, 40) // fail and terminate
+//	// never runs
+func BetweenNow(t *testing.T, v, lo, hi any, message ...any) error {
+	t.Helper()
+
+	return tryBetween(t, true, v, lo, hi, compareTypeEqual, message...)
+}
+
+// BetweenExclusive tests whether the value is between the lower bound and the upper bound (both
+// exclusive), and it set the result to fail if the value is not in the range.
+//
+//	assert.BetweenExclusive(t, 42, 0, 100) // success
+//	assert.BetweenExclusive(t, 40, 0, 40) // fail: 40 not in (0, 40)
+func BetweenExclusive(t *testing.T, v, lo, hi any, message ...any) error {
+	t.Helper()
+
+	return tryBetween(t, false, v, lo, hi, 0, message...)
+}
+
+// BetweenExclusiveNow tests whether the value is between the lower bound and the upper bound
+// (both exclusive), and it will terminate the execution if the value is not in the range.
+//
+//	assert.BetweenExclusiveNow(t, 42, 0, 100) // success
+//	assert.BetweenExclusiveNow(t, 40, 0, 40) // fail and terminate
+//	// never runs
+func BetweenExclusiveNow(t *testing.T, v, lo, hi any, message ...any) error {
+	t.Helper()
+
+	return tryBetween(t, true, v, lo, hi, 0, message...)
+}
+
+// InDelta tests whether the difference between the actual value and the expected value is not
+// greater than the delta, and it set the result to fail if the difference is greater than the
+// delta. It supports integers, durations, and floating numbers, and the values and the delta must
+// be the same type.
+//
+//	assert.InDelta(t, 42, 40, 2) // success
+//	assert.InDelta(t, time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	assert.InDelta(t, 42, 40, 1) // fail
+func InDelta(t *testing.T, actual, expect, delta any, message ...any) error {
+	t.Helper()
+
+	return tryInDelta(t, false, actual, expect, delta, message...)
+}
+
+// InDeltaNow tests whether the difference between the actual value and the expected value is not
+// greater than the delta, and it will terminate the execution if the difference is greater than
+// the delta.
+//
+//	assert.InDeltaNow(t, 42, 40, 2) // success
+//	assert.InDeltaNow(t, 42, 40, 1) // fail and terminate
+//	// never runs
+func InDeltaNow(t *testing.T, actual, expect, delta any, message ...any) error {
+	t.Helper()
+
+	return tryInDelta(t, true, actual, expect, delta, message...)
+}
+
+// InEpsilonPercent tests whether the relative difference between the actual value and the
+// expected value is not greater than the percentage of the expected value, and it set the result
+// to fail if the relative difference is greater than the percentage.
+//
+//	assert.InEpsilonPercent(t, 105, 100, 5) // success
+//	assert.InEpsilonPercent(t, 1100*time.Millisecond, time.Second, 10) // success
+//	assert.InEpsilonPercent(t, 106, 100, 5) // fail
+func InEpsilonPercent(t *testing.T, actual, expect any, percent float64, message ...any) error {
+	t.Helper()
+
+	return tryInEpsilonPercent(t, false, actual, expect, percent, message...)
+}
+
+// InEpsilonPercentNow tests whether the relative difference between the actual value and the
+// expected value is not greater than the percentage of the expected value, and it will terminate
+// the execution if the relative difference is greater than the percentage.
+//
+//	assert.InEpsilonPercentNow(t, 105, 100, 5) // success
+//	assert.InEpsilonPercentNow(t, 106, 100, 5) // fail and terminate
+//	// never runs
+func InEpsilonPercentNow(t *testing.T, actual, expect any, percent float64, message ...any) error {
+	t.Helper()
+
+	return tryInEpsilonPercent(t, true, actual, expect, percent, message...)
+}
+
+// FloatEqualWith tests the equality between actual and expect numbers with the tolerance, and it
+// set the result to fail if they are not equal. It supports floating numbers, complex numbers,
+// and integers.
+//
+//	assert.FloatEqualWith(t, 1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // success
+//	assert.FloatEqualWith(t, 1.0, math.Nextafter(1.0, 2), assert.FloatTolerance{ULP: 1}) // success
+//	assert.FloatEqualWith(t, math.NaN(), math.NaN(), assert.FloatTolerance{NaNEqual: true}) // success
+//	assert.FloatEqualWith(t, 1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // fail
+func FloatEqualWith(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatEqualWith(t, false, actual, expect, tolerance, message...)
+}
+
+// FloatEqualWithNow tests the equality between actual and expect numbers with the tolerance, and
+// it will terminate the execution if they are not equal.
+//
+//	assert.FloatEqualWithNow(t, 1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // success
+//	assert.FloatEqualWithNow(t, 1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // fail and terminate
+//	// never runs
+func FloatEqualWithNow(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatEqualWith(t, true, actual, expect, tolerance, message...)
+}
+
+// FloatNotEqualWith tests the inequality between actual and expect numbers with the tolerance,
+// and it set the result to fail if they are equal.
+//
+//	assert.FloatNotEqualWith(t, 1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // success
+//	assert.FloatNotEqualWith(t, math.NaN(), math.NaN(), assert.FloatTolerance{}) // success
+//	assert.FloatNotEqualWith(t, 1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // fail
+func FloatNotEqualWith(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatNotEqualWith(t, false, actual, expect, tolerance, message...)
+}
+
+// FloatNotEqualWithNow tests the inequality between actual and expect numbers with the tolerance,
+// and it will terminate the execution if they are equal.
+//
+//	assert.FloatNotEqualWithNow(t, 1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // success
+//	assert.FloatNotEqualWithNow(t, 1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // fail and terminate
+//	// never runs
+func FloatNotEqualWithNow(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatNotEqualWith(t, true, actual, expect, tolerance, message...)
+}
+
+// FloatsEqual tests the element-wise equality between the actual and expect slices or arrays of
+// numbers with the tolerance, and it set the result to fail if they have different lengths or any
+// pair of the elements is not equal. It also supports nested slices or arrays like matrices, and
+// the index of the first mismatched element will be reported in the message.
+//
+//	tol := assert.FloatTolerance{Abs: 0.01}
+//	assert.FloatsEqual(t, []float64{1.0, 2.0}, []float64{1.0, 2.001}, tol) // success
+//	assert.FloatsEqual(t, [][]float64{{1.0}, {2.0}}, [][]float64{{1.0}, {2.1}}, tol) // fail
+func FloatsEqual(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatsEqual(t, false, actual, expect, tolerance, message...)
+}
+
+// FloatsEqualNow tests the element-wise equality between the actual and expect slices or arrays
+// of numbers with the tolerance, and it will terminate the execution if they have different
+// lengths or any pair of the elements is not equal.
+//
+//	tol := assert.FloatTolerance{Abs: 0.01}
+//	assert.FloatsEqualNow(t, []float64{1.0, 2.0}, []float64{1.0, 2.001}, tol) // success
+//	assert.FloatsEqualNow(t, []float64{1.0, 2.0}, []float64{1.0}, tol) // fail and terminate
+//	// never runs
+func FloatsEqualNow(
+	t *testing.T,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryFloatsEqual(t, true, actual, expect, tolerance, message...)
+}
+
+// TimeEqual tests whether the times represent the same time instant or not, and it set the
+// result to fail if they are not the same instant. Unlike Equal, it ignores the location and the
+// monotonic clock reading of the times.
+//
+//	now := time.Now()
+//	assert.TimeEqual(t, now, now.UTC()) // success
+//	assert.TimeEqual(t, now, now.Add(time.Second)) // fail
+func TimeEqual(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryTimeEqual(t, false, actual, expect, message...)
+}
+
+// TimeEqualNow tests whether the times represent the same time instant or not, and it will
+// terminate the execution if they are not the same instant.
+//
+//	now := time.Now()
+//	assert.TimeEqualNow(t, now, now.UTC()) // success
+//	assert.TimeEqualNow(t, now, now.Add(time.Second)) // fail and terminate
+//	// never runs
+func TimeEqualNow(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryTimeEqual(t, true, actual, expect, message...)
+}
+
+// WithinDuration tests whether the difference between the times is not greater than the
+// duration, and it set the result to fail if the difference is greater than the duration.
+//
+//	now := time.Now()
+//	assert.WithinDuration(t, now, now.Add(time.Second), time.Second) // success
+//	assert.WithinDuration(t, now, now.Add(-time.Second), time.Second) // success
+//	assert.WithinDuration(t, now, now.Add(time.Minute), time.Second) // fail
+func WithinDuration(
+	t *testing.T,
+	actual, expect time.Time,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryWithinDuration(t, false, actual, expect, d, message...)
+}
+
+// WithinDurationNow tests whether the difference between the times is not greater than the
+// duration, and it will terminate the execution if the difference is greater than the duration.
+//
+//	now := time.Now()
+//	assert.WithinDurationNow(t, now, now.Add(time.Second), time.Second) // success
+//	assert.WithinDurationNow(t, now, now.Add(time.Minute), time.Second) // fail and terminate
+//	// never runs
+func WithinDurationNow(
+	t *testing.T,
+	actual, expect time.Time,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryWithinDuration(t, true, actual, expect, d, message...)
+}
+
+// Before tests whether the actual time is before the expected time, and it set the result to
+// fail if the actual time is not before the expected time.
+//
+//	now := time.Now()
+//	assert.Before(t, now, now.Add(time.Second)) // success
+//	assert.Before(t, now, now) // fail
+func Before(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryBefore(t, false, actual, expect, message...)
+}
+
+// BeforeNow tests whether the actual time is before the expected time, and it will terminate the
+// execution if the actual time is not before the expected time.
+//
+//	now := time.Now()
+//	assert.BeforeNow(t, now, now.Add(time.Second)) // success
+//	assert.BeforeNow(t, now, now) // fail and terminate
+//	// never runs
+func BeforeNow(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryBefore(t, true, actual, expect, message...)
+}
+
+// After tests whether the actual time is after the expected time, and it set the result to fail
+// if the actual time is not after the expected time.
+//
+//	now := time.Now()
+//	assert.After(t, now, now.Add(-time.Second)) // success
+//	assert.After(t, now, now) // fail
+func After(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryAfter(t, false, actual, expect, message...)
+}
+
+// AfterNow tests whether the actual time is after the expected time, and it will terminate the
+// execution if the actual time is not after the expected time.
+//
+//	now := time.Now()
+//	assert.AfterNow(t, now, now.Add(-time.Second)) // success
+//	assert.AfterNow(t, now, now) // fail and terminate
+//	// never runs
+func AfterNow(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return tryAfter(t, true, actual, expect, message...)
+}
+
+// SameDay tests whether the times are in the same day, and it set the result to fail if they are
+// not in the same day. The actual time will be converted to the location of the expected time
+// before comparing.
+//
+//	assert.SameDay(
+//	  t,
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+//	) // success
+//	assert.SameDay(
+//	  t,
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+//	) // fail
+func SameDay(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return trySameDay(t, false, actual, expect, message...)
+}
+
+// SameDayNow tests whether the times are in the same day, and it will terminate the execution if
+// they are not in the same day. The actual time will be converted to the location of the expected
+// time before comparing.
+//
+//	assert.SameDayNow(
+//	  t,
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+//	) // fail and terminate
+//	// never runs
+func SameDayNow(t *testing.T, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return trySameDay(t, true, actual, expect, message...)
+}
+
+// TimeInLocation tests whether the time is in the specified location, and it set the result to
+// fail if the location of the time is not the specified location.
+//
+//	assert.TimeInLocation(t, time.Now().UTC(), time.UTC) // success
+//	assert.TimeInLocation(t, time.Now().In(time.Local), time.UTC) // fail if the local time zone is not UTC
+func TimeInLocation(t *testing.T, tm time.Time, loc *time.Location, message ...any) error {
+	t.Helper()
+
+	return tryTimeInLocation(t, false, tm, loc, message...)
+}
+
+// TimeInLocationNow tests whether the time is in the specified location, and it will terminate
+// the execution if the location of the time is not the specified location.
+//
+//	assert.TimeInLocationNow(t, time.Now().UTC(), time.UTC) // success
+//	assert.TimeInLocationNow(t, time.Now().In(time.Local), time.UTC) // fail and terminate
+//	// never runs
+func TimeInLocationNow(t *testing.T, tm time.Time, loc *time.Location, message ...any) error {
+	t.Helper()
+
+	return tryTimeInLocation(t, true, tm, loc, message...)
+}
+
+// DurationWithin tests whether the difference between the durations is not greater than the
+// delta, and it set the result to fail if the difference is greater than the delta.
+//
+//	assert.DurationWithin(t, time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	assert.DurationWithin(t, time.Second, 800*time.Millisecond, 100*time.Millisecond) // fail
+func DurationWithin(t *testing.T, actual, expect, delta time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryDurationWithin(t, false, actual, expect, delta, message...)
+}
+
+// DurationWithinNow tests whether the difference between the durations is not greater than the
+// delta, and it will terminate the execution if the difference is greater than the delta.
+//
+//	assert.DurationWithinNow(t, time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	assert.DurationWithinNow(t, time.Second, 800*time.Millisecond, 100*time.Millisecond) // fail and terminate
+//	// never runs
+func DurationWithinNow(t *testing.T, actual, expect, delta time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryDurationWithin(t, true, actual, expect, delta, message...)
+}
+
+// NoError tests whether the error is nil or not, and it set the result to fail if the error is not
+// nil. The message will contain the whole chain of the wrapped errors.
+//
+//	assert.NoError(t, nil) // success
+//	assert.NoError(t, errors.New("some error")) // fail
+func NoError(t *testing.T, err error, message ...any) error {
+	t.Helper()
+
+	return tryNoError(t, false, err, message...)
+}
+
+// NoErrorNow tests whether the error is nil or not, and it will terminate the execution if the
+// error is not nil.
+//
+//	assert.NoErrorNow(t, nil) // success
+//	assert.NoErrorNow(t, errors.New("some error")) // fail and terminate
+//	// never runs
+func NoErrorNow(t *testing.T, err error, message ...any) error {
+	t.Helper()
+
+	return tryNoError(t, true, err, message...)
+}
+
+// HasError tests whether the error is nil or not, and it set the result to fail if the error is
+// nil.
+//
+//	assert.HasError(t, errors.New("some error")) // success
+//	assert.HasError(t, nil) // fail
+func HasError(t *testing.T, err error, message ...any) error {
+	t.Helper()
+
+	return tryHasError(t, false, err, message...)
+}
+
+// HasErrorNow tests whether the error is nil or not, and it will terminate the execution if the
+// error is nil.
+//
+//	assert.HasErrorNow(t, errors.New("some error")) // success
+//	assert.HasErrorNow(t, nil) // fail and terminate
+//	// never runs
+func HasErrorNow(t *testing.T, err error, message ...any) error {
+	t.Helper()
+
+	return tryHasError(t, true, err, message...)
+}
+
+// ErrorAs tests whether any error in the chain of the error matches the target, and sets the
+// target to the matched error like `errors.As`. It set the result to fail if no error in the
+// chain matches the target, and it'll panic if the target is not a non-nil pointer to either a
+// type that implements error, or to any interface type.
+//
+//	var pathErr *fs.PathError
+//	_, err := os.Open("not-exist-file")
+//	assert.ErrorAs(t, err, &pathErr) // success
+//	assert.ErrorAs(t, errors.New("some error"), &pathErr) // fail
+func ErrorAs(t *testing.T, err error, target any, message ...any) error {
+	t.Helper()
+
+	return tryErrorAs(t, false, err, target, message...)
+}
+
+// ErrorAsNow tests whether any error in the chain of the error matches the target, and sets the
+// target to the matched error like `errors.As`. It will terminate the execution if no error in the
+// chain matches the target.
+//
+//	var pathErr *fs.PathError
+//	_, err := os.Open("not-exist-file")
+//	assert.ErrorAsNow(t, err, &pathErr) // success
+//	assert.ErrorAsNow(t, errors.New("some error"), &pathErr) // fail and terminate
+//	// never runs
+func ErrorAsNow(t *testing.T, err error, target any, message ...any) error {
+	t.Helper()
+
+	return tryErrorAs(t, true, err, target, message...)
+}
+
+// ErrorContains tests whether the message of the error contains the substring, and it set the
+// result to fail if the error is nil or its message does not contain the substring.
+//
+//	assert.ErrorContains(t, errors.New("some error"), "some") // success
+//	assert.ErrorContains(t, errors.New("some error"), "other") // fail
+//	assert.ErrorContains(t, nil, "some") // fail
+func ErrorContains(t *testing.T, err error, substr string, message ...any) error {
+	t.Helper()
+
+	return tryErrorContains(t, false, err, substr, message...)
+}
+
+// ErrorContainsNow tests whether the message of the error contains the substring, and it will
+// terminate the execution if the error is nil or its message does not contain the substring.
+//
+//	assert.ErrorContainsNow(t, errors.New("some error"), "some") // success
+//	assert.ErrorContainsNow(t, errors.New("some error"), "other") // fail and terminate
+//	// never runs
+func ErrorContainsNow(t *testing.T, err error, substr string, message ...any) error {
+	t.Helper()
+
+	return tryErrorContains(t, true, err, substr, message...)
+}
+
+// ErrorMatches tests whether the message of the error matches the regular expression pattern, and
+// it set the result to fail if the error is nil or its message does not match the pattern. It'll
+// panic if the pattern is not a valid regular expression.
+//
+//	assert.ErrorMatches(t, errors.New("error code 404"), `code \d+`) // success
+//	assert.ErrorMatches(t, errors.New("error code 404"), `^code`) // fail
+func ErrorMatches(t *testing.T, err error, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryErrorMatches(t, false, err, pattern, message...)
+}
+
+// ErrorMatchesNow tests whether the message of the error matches the regular expression pattern,
+// and it will terminate the execution if the error is nil or its message does not match the
+// pattern. It'll panic if the pattern is not a valid regular expression.
+//
+//	assert.ErrorMatchesNow(t, errors.New("error code 404"), `code \d+`) // success
+//	assert.ErrorMatchesNow(t, errors.New("error code 404"), `^code`) // fail and terminate
+//	// never runs
+func ErrorMatchesNow(t *testing.T, err error, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryErrorMatches(t, true, err, pattern, message...)
+}
+
+// ErrorType tests whether any error in the chain of the error has the same type as the expected
+// error, and it set the result to fail if no error in the chain has the same type.
+//
+//	_, err := os.Open("not-exist-file")
+//	assert.ErrorType(t, err, &fs.PathError{}) // success
+//	assert.ErrorType(t, errors.New("some error"), &fs.PathError{}) // fail
+func ErrorType(t *testing.T, err, expected error, message ...any) error {
+	t.Helper()
+
+	return tryErrorType(t, false, err, expected, message...)
+}
+
+// ErrorTypeNow tests whether any error in the chain of the error has the same type as the
+// expected error, and it will terminate the execution if no error in the chain has the same type.
+//
+//	_, err := os.Open("not-exist-file")
+//	assert.ErrorTypeNow(t, err, &fs.PathError{}) // success
+//	assert.ErrorTypeNow(t, errors.New("some error"), &fs.PathError{}) // fail and terminate
+//	// never runs
+func ErrorTypeNow(t *testing.T, err, expected error, message ...any) error {
+	t.Helper()
+
+	return tryErrorType(t, true, err, expected, message...)
+}
+
+// ErrorEqualMessage tests whether the message of the error equals to the expected message, and it
+// set the result to fail if the error is nil or its message is not the expected message.
+//
+//	assert.ErrorEqualMessage(t, errors.New("some error"), "some error") // success
+//	assert.ErrorEqualMessage(t, errors.New("some error"), "other error") // fail
+func ErrorEqualMessage(t *testing.T, err error, expected string, message ...any) error {
+	t.Helper()
+
+	return tryErrorEqualMessage(t, false, err, expected, message...)
+}
+
+// ErrorEqualMessageNow tests whether the message of the error equals to the expected message, and
+// it will terminate the execution if the error is nil or its message is not the expected message.
+//
+//	assert.ErrorEqualMessageNow(t, errors.New("some error"), "some error") // success
+//	assert.ErrorEqualMessageNow(t, errors.New("some error"), "other error") // fail and terminate
+//	// never runs
+func ErrorEqualMessageNow(t *testing.T, err error, expected string, message ...any) error {
+	t.Helper()
+
+	return tryErrorEqualMessage(t, true, err, expected, message...)
+}
+
+// ErrorChainEqual tests whether the sentinel errors that wrapped by the error are the expected
+// errors in order, and it set the result to fail if any expected error is missing, any other
+// sentinel error is wrapped, or they are in a different order. The sentinel errors are the errors
+// in the tree of the error that do not wrap other errors.
+//
+//	ErrNotFound := errors.New("not found")
+//	ErrIO := errors.New("io error")
+//	err := fmt.Errorf("read: %w", fmt.Errorf("%w: %w", ErrIO, ErrNotFound))
+//	assert.ErrorChainEqual(t, err, []error{ErrIO, ErrNotFound}) // success
+//	assert.ErrorChainEqual(t, err, []error{ErrNotFound, ErrIO}) // fail
+//	assert.ErrorChainEqual(t, err, []error{ErrNotFound}) // fail
+func ErrorChainEqual(t *testing.T, err error, expected []error, message ...any) error {
+	t.Helper()
+
+	return tryErrorChainEqual(t, false, err, expected, message...)
+}
+
+// ErrorChainEqualNow tests whether the sentinel errors that wrapped by the error are the expected
+// errors in order, and it will terminate the execution if any expected error is missing, any other
+// sentinel error is wrapped, or they are in a different order.
+//
+//	ErrNotFound := errors.New("not found")
+//	err := fmt.Errorf("read: %w", ErrNotFound)
+//	assert.ErrorChainEqualNow(t, err, []error{ErrNotFound}) // success
+//	assert.ErrorChainEqualNow(t, err, []error{io.EOF}) // fail and terminate
+//	// never runs
+func ErrorChainEqualNow(t *testing.T, err error, expected []error, message ...any) error {
+	t.Helper()
+
+	return tryErrorChainEqual(t, true, err, expected, message...)
+}
+
+// PanicIs expects the function fn to panic by an error that matches the target error by
+// `errors.Is`. If the function does not panic, or the recovered value is not an error or does not
+// match the target, it will set the result to fail.
+//
+//	assert.PanicIs(t, func() {
+//	  panic(fmt.Errorf("read config: %w", ErrNotFound))
+//	}, ErrNotFound) // success
+//	assert.PanicIs(t, func() {
+//	  panic("not found")
+//	}, ErrNotFound) // fail
+func PanicIs(t *testing.T, fn func(), target error, message ...any) error {
+	t.Helper()
+
+	return tryPanicIs(t, false, fn, target, message...)
+}
+
+// PanicIsNow expects the function fn to panic by an error that matches the target error by
+// `errors.Is`. If the function does not panic, or the recovered value is not an error or does not
+// match the target, it will set the result to fail and terminate the execution.
+//
+//	assert.PanicIsNow(t, func() {
+//	  panic(fmt.Errorf("read config: %w", ErrNotFound))
+//	}, ErrNotFound) // success
+//	assert.PanicIsNow(t, func() {
+//	  panic("not found")
+//	}, ErrNotFound) // fail and terminate
+//	// never runs
+func PanicIsNow(t *testing.T, fn func(), target error, message ...any) error {
+	t.Helper()
+
+	return tryPanicIs(t, true, fn, target, message...)
+}
+
+// PanicAs expects the function fn to panic by an error that matches the target by `errors.As`,
+// and sets the target to the matched error. If the function does not panic, or the recovered value
+// is not an error or does not match the target, it will set the result to fail.
+//
+//	var pathErr *fs.PathError
+//	assert.PanicAs(t, func() {
+//	  _, err := os.Open("not-exist-file")
+//	  panic(err)
+//	}, &pathErr) // success
+//	assert.PanicAs(t, func() {
+//	  panic("some error")
+//	}, &pathErr) // fail
+func PanicAs(t *testing.T, fn func(), target any, message ...any) error {
+	t.Helper()
+
+	return tryPanicAs(t, false, fn, target, message...)
+}
+
+// PanicAsNow expects the function fn to panic by an error that matches the target by `errors.As`,
+// and sets the target to the matched error. If the function does not panic, or the recovered value
+// is not an error or does not match the target, it will set the result to fail and terminate the
+// execution.
+//
+//	var pathErr *fs.PathError
+//	assert.PanicAsNow(t, func() {
+//	  _, err := os.Open("not-exist-file")
+//	  panic(err)
+//	}, &pathErr) // success
+//	assert.PanicAsNow(t, func() {
+//	  panic("some error")
+//	}, &pathErr) // fail and terminate
+//	// never runs
+func PanicAsNow(t *testing.T, fn func(), target any, message ...any) error {
+	t.Helper()
+
+	return tryPanicAs(t, true, fn, target, message...)
+}
+
+// PanicMatch expects the function fn to panic, and the message of the recovered value matches the
+// regular expression pattern. If the function does not panic, or the message does not match the
+// pattern, it will set the result to fail. The message of an error is the result of its `Error`
+// method, and the message of other values is formatted by `fmt.Sprint`.
+//
+//	assert.PanicMatch(t, func() {
+//	  panic(fmt.Sprintf("index %d out of range", 10))
+//	}, `index \d+ out of range`) // success
+//	assert.PanicMatch(t, func() {
+//	  panic("some error")
+//	}, `index \d+ out of range`) // fail
+func PanicMatch(t *testing.T, fn func(), pattern string, message ...any) error {
+	t.Helper()
+
+	return tryPanicMatch(t, false, fn, pattern, message...)
+}
+
+// PanicMatchNow expects the function fn to panic, and the message of the recovered value matches
+// the regular expression pattern. If the function does not panic, or the message does not match
+// the pattern, it will set the result to fail and terminate the execution.
+//
+//	assert.PanicMatchNow(t, func() {
+//	  panic(fmt.Sprintf("index %d out of range", 10))
+//	}, `index \d+ out of range`) // success
+//	assert.PanicMatchNow(t, func() {
+//	  panic("some error")
+//	}, `index \d+ out of range`) // fail and terminate
+//	// never runs
+func PanicMatchNow(t *testing.T, fn func(), pattern string, message ...any) error {
+	t.Helper()
+
+	return tryPanicMatch(t, true, fn, pattern, message...)
+}
+
+// PanicContains expects the function fn to panic, and the message of the recovered value contains
+// the substring. If the function does not panic, or the message does not contain the substring, it
+// will set the result to fail.
+//
+//	assert.PanicContains(t, func() {
+//	  panic(fmt.Sprintf("user %d not found", 42))
+//	}, "not found") // success
+//	assert.PanicContains(t, func() {
+//	  panic("some error")
+//	}, "not found") // fail
+func PanicContains(t *testing.T, fn func(), substr string, message ...any) error {
+	t.Helper()
+
+	return tryPanicContains(t, false, fn, substr, message...)
+}
+
+// PanicContainsNow expects the function fn to panic, and the message of the recovered value
+// contains the substring. If the function does not panic, or the message does not contain the
+// substring, it will set the result to fail and terminate the execution.
+//
+//	assert.PanicContainsNow(t, func() {
+//	  panic(fmt.Sprintf("user %d not found", 42))
+//	}, "not found") // success
+//	assert.PanicContainsNow(t, func() {
+//	  panic("some error")
+//	}, "not found") // fail and terminate
+//	// never runs
+func PanicContainsNow(t *testing.T, fn func(), substr string, message ...any) error {
+	t.Helper()
+
+	return tryPanicContains(t, true, fn, substr, message...)
+}
+
+// PanicWith expects the function fn to panic, and the recovered value satisfies the predicate. If
+// the function does not panic, or the predicate returns false, it will set the result to fail.
+//
+//	assert.PanicWith(t, func() {
+//	  panic(42)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // success
+//	assert.PanicWith(t, func() {
+//	  panic(0)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // fail
+func PanicWith(t *testing.T, fn func(), predicate func(v any) bool, message ...any) error {
+	t.Helper()
+
+	return tryPanicWith(t, false, fn, predicate, message...)
+}
+
+// PanicWithNow expects the function fn to panic, and the recovered value satisfies the predicate.
+// If the function does not panic, or the predicate returns false, it will set the result to fail
+// and terminate the execution.
+//
+//	assert.PanicWithNow(t, func() {
+//	  panic(42)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // success
+//	assert.PanicWithNow(t, func() {
+//	  panic(0)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // fail and terminate
+//	// never runs
+func PanicWithNow(t *testing.T, fn func(), predicate func(v any) bool, message ...any) error {
+	t.Helper()
+
+	return tryPanicWith(t, true, fn, predicate, message...)
+}
+
+// PanicRuntimeError expects the function fn to panic by a runtime error, like nil pointer
+// dereference, index out of range, or integer divide by zero. If the function does not panic, or
+// the recovered value is not a `runtime.Error`, it will set the result to fail.
+//
+//	assert.PanicRuntimeError(t, func() {
+//	  var m map[string]int
+//	  m["key"] = 1
+//	}) // success
+//	assert.PanicRuntimeError(t, func() {
+//	  panic("some error")
+//	}) // fail
+func PanicRuntimeError(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(t, false, fn, "", nil, message...)
+}
+
+// PanicRuntimeErrorNow expects the function fn to panic by a runtime error. If the function does
+// not panic, or the recovered value is not a `runtime.Error`, it will set the result to fail and
+// terminate the execution.
+//
+//	assert.PanicRuntimeErrorNow(t, func() {
+//	  var m map[string]int
+//	  m["key"] = 1
+//	}) // success
+//	assert.PanicRuntimeErrorNow(t, func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func PanicRuntimeErrorNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(t, true, fn, "", nil, message...)
+}
+
+// PanicNilDereference expects the function fn to panic by a nil pointer dereference. If the
+// function does not panic, or the recovered value is not the runtime error of the nil pointer
+// dereference, it will set the result to fail.
+//
+//	assert.PanicNilDereference(t, func() {
+//	  var p *int
+//	  _ = *p
+//	}) // success
+//	assert.PanicNilDereference(t, func() {
+//	  panic("some error")
+//	}) // fail
+func PanicNilDereference(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, false, fn, runtimeErrorNilDereference, isNilDereferenceError, message...,
+	)
+}
+
+// PanicNilDereferenceNow expects the function fn to panic by a nil pointer dereference. If the
+// function does not panic, or the recovered value is not the runtime error of the nil pointer
+// dereference, it will set the result to fail and terminate the execution.
+//
+//	assert.PanicNilDereferenceNow(t, func() {
+//	  var p *int
+//	  _ = *p
+//	}) // success
+//	assert.PanicNilDereferenceNow(t, func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func PanicNilDereferenceNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, true, fn, runtimeErrorNilDereference, isNilDereferenceError, message...,
+	)
+}
+
+// PanicIndexOutOfRange expects the function fn to panic by an index out of range, including the
+// slice bounds out of range. If the function does not panic, or the recovered value is not the
+// runtime error of the index out of range, it will set the result to fail.
+//
+//	assert.PanicIndexOutOfRange(t, func() {
+//	  s := []int{}
+//	  _ = s[1]
+//	}) // success
+//	assert.PanicIndexOutOfRange(t, func() {
+//	  panic("some error")
+//	}) // fail
+func PanicIndexOutOfRange(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, false, fn, runtimeErrorIndexOutOfRange, isIndexOutOfRangeError, message...,
+	)
+}
+
+// PanicIndexOutOfRangeNow expects the function fn to panic by an index out of range, including the
+// slice bounds out of range. If the function does not panic, or the recovered value is not the
+// runtime error of the index out of range, it will set the result to fail and terminate the
+// execution.
+//
+//	assert.PanicIndexOutOfRangeNow(t, func() {
+//	  s := []int{}
+//	  _ = s[1]
+//	}) // success
+//	assert.PanicIndexOutOfRangeNow(t, func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func PanicIndexOutOfRangeNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, true, fn, runtimeErrorIndexOutOfRange, isIndexOutOfRangeError, message...,
+	)
+}
+
+// PanicDivideByZero expects the function fn to panic by an integer divide by zero. If the function
+// does not panic, or the recovered value is not the runtime error of the integer divide by zero, it
+// will set the result to fail.
+//
+//	assert.PanicDivideByZero(t, func() {
+//	  n := 0
+//	  _ = 1 / n
+//	}) // success
+//	assert.PanicDivideByZero(t, func() {
+//	  panic("some error")
+//	}) // fail
+func PanicDivideByZero(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, false, fn, runtimeErrorDivideByZero, isDivideByZeroError, message...,
+	)
+}
+
+// PanicDivideByZeroNow expects the function fn to panic by an integer divide by zero. If the
+// function does not panic, or the recovered value is not the runtime error of the integer divide
+// by zero, it will set the result to fail and terminate the execution.
+//
+//	assert.PanicDivideByZeroNow(t, func() {
+//	  n := 0
+//	  _ = 1 / n
+//	}) // success
+//	assert.PanicDivideByZeroNow(t, func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func PanicDivideByZeroNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, true, fn, runtimeErrorDivideByZero, isDivideByZeroError, message...,
+	)
+}
+
+// PanicTypeAssertion expects the function fn to panic by a failed type assertion. If the function
+// does not panic, or the recovered value is not a `*runtime.TypeAssertionError`, it will set the
+// result to fail.
+//
+//	assert.PanicTypeAssertion(t, func() {
+//	  var v any = "string"
+//	  _ = v.(int)
+//	}) // success
+//	assert.PanicTypeAssertion(t, func() {
+//	  panic("some error")
+//	}) // fail
+func PanicTypeAssertion(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, false, fn, runtimeErrorTypeAssertion, isTypeAssertionError, message...,
+	)
+}
+
+// PanicTypeAssertionNow expects the function fn to panic by a failed type assertion. If the
+// function does not panic, or the recovered value is not a `*runtime.TypeAssertionError`, it will
+// set the result to fail and terminate the execution.
+//
+//	assert.PanicTypeAssertionNow(t, func() {
+//	  var v any = "string"
+//	  _ = v.(int)
+//	}) // success
+//	assert.PanicTypeAssertionNow(t, func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func PanicTypeAssertionNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryPanicRuntimeError(
+		t, true, fn, runtimeErrorTypeAssertion, isTypeAssertionError, message...,
+	)
+}
+
+// NoGoroutineLeak runs the function fn, and tests whether all the goroutines started during the
+// function call have exited or not. It'll wait for the goroutines to exit for a grace period of
+// one second, and set the result to fail with the stacks of the leaked goroutines if any goroutine
+// is still running after the grace period.
+//
+//	assert.NoGoroutineLeak(t, func() {
+//	  done := make(chan struct{})
+//	  go func() { <-done }()
+//	  close(done)
+//	}) // success
+//	assert.NoGoroutineLeak(t, func() {
+//	  go func() { select {} }()
+//	}) // fail
+func NoGoroutineLeak(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryNoGoroutineLeak(t, false, fn, LeakOptions{}, message...)
+}
+
+// NoGoroutineLeakNow runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not. It'll terminate the execution if any goroutine is still
+// running after the grace period.
+//
+//	assert.NoGoroutineLeakNow(t, func() {
+//	  go func() { select {} }()
+//	}) // fail and terminate
+//	// never runs
+func NoGoroutineLeakNow(t *testing.T, fn func(), message ...any) error {
+	t.Helper()
+
+	return tryNoGoroutineLeak(t, true, fn, LeakOptions{}, message...)
+}
+
+// NoGoroutineLeakWith runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not with the options. The goroutines whose stacks match any of
+// the ignore patterns will not be treated as leaked.
+//
+//	assert.NoGoroutineLeakWith(t, func() {
+//	  go backgroundWorker()
+//	}, assert.LeakOptions{Ignores: []string{`backgroundWorker`}}) // success
+//	assert.NoGoroutineLeakWith(t, func() {
+//	  go func() { time.Sleep(time.Second) }()
+//	}, assert.LeakOptions{GracePeriod: 100 * time.Millisecond}) // fail
+func NoGoroutineLeakWith(t *testing.T, fn func(), options LeakOptions, message ...any) error {
+	t.Helper()
+
+	return tryNoGoroutineLeak(t, false, fn, options, message...)
+}
+
+// NoGoroutineLeakWithNow runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not with the options. It'll terminate the execution if any
+// goroutine is still running after the grace period.
+//
+//	assert.NoGoroutineLeakWithNow(t, func() {
+//	  go func() { time.Sleep(time.Second) }()
+//	}, assert.LeakOptions{GracePeriod: 100 * time.Millisecond}) // fail and terminate
+//	// never runs
+func NoGoroutineLeakWithNow(t *testing.T, fn func(), options LeakOptions, message ...any) error {
+	t.Helper()
+
+	return tryNoGoroutineLeak(t, true, fn, options, message...)
+}
+
+// VerifyNoLeaks takes a snapshot of the running goroutines, and registers a cleanup function to
+// the test to check whether all the goroutines started after calling it have exited when the test
+// and all its subtests completed. It'll set the result to fail with the stacks of the leaked
+// goroutines if any goroutine is still running after the grace period.
+//
+//	func TestSomething(t *testing.T) {
+//	  assert.VerifyNoLeaks(t)
+//	  // ...
+//	}
+func VerifyNoLeaks(t *testing.T) {
+	t.Helper()
+
+	verifyNoLeaks(t, LeakOptions{})
+}
+
+// VerifyNoLeaksWith takes a snapshot of the running goroutines, and registers a cleanup function
+// to the test to check whether all the goroutines started after calling it have exited with the
+// options when the test and all its subtests completed.
+//
+//	func TestSomething(t *testing.T) {
+//	  assert.VerifyNoLeaksWith(t, assert.LeakOptions{Ignores: []string{`backgroundWorker`}})
+//	  // ...
+//	}
+func VerifyNoLeaksWith(t *testing.T, options LeakOptions) {
+	t.Helper()
+
+	verifyNoLeaks(t, options)
+}
+
+// CompletesWithin runs the function fn in a new goroutine, and tests whether it returns within the
+// duration. It'll set the result to fail with the stacks of all the goroutines if the function is
+// still running after the duration, and it'll also fail if the function panics.
+//
+//	assert.CompletesWithin(t, func() {
+//	  time.Sleep(10 * time.Millisecond)
+//	}, time.Second) // success
+//	assert.CompletesWithin(t, func() {
+//	  select {}
+//	}, time.Second) // fail
+func CompletesWithin(t *testing.T, fn func(), d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryCompletesWithin(t, false, fn, d, message...)
+}
+
+// CompletesWithinNow runs the function fn in a new goroutine, and tests whether it returns within
+// the duration. It'll terminate the execution if the function is still running after the duration
+// or the function panics.
+//
+//	assert.CompletesWithinNow(t, func() {
+//	  time.Sleep(10 * time.Millisecond)
+//	}, time.Second) // success
+//	assert.CompletesWithinNow(t, func() {
+//	  select {}
+//	}, time.Second) // fail and terminate
+//	// never runs
+func CompletesWithinNow(t *testing.T, fn func(), d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryCompletesWithin(t, true, fn, d, message...)
+}
+
+// Blocks runs the function fn in a new goroutine, and tests whether it is still running after the
+// duration. It'll set the result to fail if the function returns or panics within the duration.
+// The function will be kept running in the background if it blocks.
+//
+//	ch := make(chan int)
+//	assert.Blocks(t, func() {
+//	  <-ch
+//	}, 10*time.Millisecond) // success
+//	assert.Blocks(t, func() {
+//	  // no blocking
+//	}, 10*time.Millisecond) // fail
+func Blocks(t *testing.T, fn func(), d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryBlocks(t, false, fn, d, message...)
+}
+
+// BlocksNow runs the function fn in a new goroutine, and tests whether it is still running after
+// the duration. It'll terminate the execution if the function returns or panics within the
+// duration.
+//
+//	ch := make(chan int)
+//	assert.BlocksNow(t, func() {
+//	  <-ch
+//	}, 10*time.Millisecond) // success
+//	assert.BlocksNow(t, func() {
+//	  // no blocking
+//	}, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func BlocksNow(t *testing.T, fn func(), d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryBlocks(t, true, fn, d, message...)
+}
+
+// Receives tests whether a value can be received from the channel within the duration, and
+// returns the received value. It'll set the result to fail if no value is received in time, or the
+// channel is closed. It'll panic if the channel is not a receivable channel.
+//
+//	ch := make(chan int, 1)
+//	ch <- 1
+//	v, _ := assert.Receives(t, ch, time.Second) // success, v is 1
+//	assert.Receives(t, ch, 10*time.Millisecond) // fail
+func Receives(t *testing.T, ch any, d time.Duration, message ...any) (any, error) {
+	t.Helper()
+
+	return tryReceives(t, false, ch, d, message...)
+}
+
+// ReceivesNow tests whether a value can be received from the channel within the duration, and
+// returns the received value. It'll terminate the execution if no value is received in time, or
+// the channel is closed.
+//
+//	ch := make(chan int, 1)
+//	ch <- 1
+//	v, _ := assert.ReceivesNow(t, ch, time.Second) // success, v is 1
+//	assert.ReceivesNow(t, ch, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func ReceivesNow(t *testing.T, ch any, d time.Duration, message ...any) (any, error) {
+	t.Helper()
+
+	return tryReceives(t, true, ch, d, message...)
+}
+
+// ReceivesValue tests whether a value can be received from the channel within the duration, and
+// the received value equals to the expected value. It'll set the result to fail if no value is
+// received in time, the channel is closed, or the received value is not the expected value.
+//
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	ch <- 2
+//	assert.ReceivesValue(t, ch, 1, time.Second) // success
+//	assert.ReceivesValue(t, ch, 1, time.Second) // fail
+func ReceivesValue(t *testing.T, ch, expected any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryReceivesValue(t, false, ch, expected, d, message...)
+}
+
+// ReceivesValueNow tests whether a value can be received from the channel within the duration, and
+// the received value equals to the expected value. It'll terminate the execution if no value is
+// received in time, the channel is closed, or the received value is not the expected value.
+//
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	ch <- 2
+//	assert.ReceivesValueNow(t, ch, 1, time.Second) // success
+//	assert.ReceivesValueNow(t, ch, 1, time.Second) // fail and terminate
+//	// never runs
+func ReceivesValueNow(t *testing.T, ch, expected any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryReceivesValue(t, true, ch, expected, d, message...)
+}
+
+// NotReceives tests whether no value is received from the channel within the duration, and it'll
+// set the result to fail if a value is received. The received value will be consumed, and a closed
+// channel is treated as no value received.
+//
+//	ch := make(chan int, 1)
+//	assert.NotReceives(t, ch, 10*time.Millisecond) // success
+//	ch <- 1
+//	assert.NotReceives(t, ch, 10*time.Millisecond) // fail
+func NotReceives(t *testing.T, ch any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryNotReceives(t, false, ch, d, message...)
+}
+
+// NotReceivesNow tests whether no value is received from the channel within the duration, and
+// it'll terminate the execution if a value is received.
+//
+//	ch := make(chan int, 1)
+//	assert.NotReceivesNow(t, ch, 10*time.Millisecond) // success
+//	ch <- 1
+//	assert.NotReceivesNow(t, ch, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func NotReceivesNow(t *testing.T, ch any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryNotReceives(t, true, ch, d, message...)
+}
+
+// Closed tests whether the channel is closed and drained, and it'll set the result to fail if the
+// channel is not closed. A closed channel that still has buffered values is treated as not closed,
+// and the buffered values will not be consumed.
+//
+// To check a channel without buffered values, it tries to receive from the channel without
+// blocking. It may receive the value of a pending sender, for example, a sender that is blocked on
+// an unbuffered channel, and the received value will be dropped and cannot be pushed back to the
+// channel.
+//
+//	ch := make(chan int)
+//	close(ch)
+//	assert.Closed(t, ch) // success
+//	assert.Closed(t, make(chan int)) // fail
+func Closed(t *testing.T, ch any, message ...any) error {
+	t.Helper()
+
+	return tryClosed(t, false, ch, message...)
+}
+
+// ClosedNow tests whether the channel is closed and drained, and it'll terminate the execution if
+// the channel is not closed.
+//
+//	ch := make(chan int)
+//	close(ch)
+//	assert.ClosedNow(t, ch) // success
+//	assert.ClosedNow(t, make(chan int)) // fail and terminate
+//	// never runs
+func ClosedNow(t *testing.T, ch any, message ...any) error {
+	t.Helper()
+
+	return tryClosed(t, true, ch, message...)
+}
+
+// NotClosed tests whether the channel is not closed, and it'll set the result to fail if the
+// channel is closed and drained.
+//
+//	assert.NotClosed(t, make(chan int)) // success
+//	ch := make(chan int)
+//	close(ch)
+//	assert.NotClosed(t, ch) // fail
+func NotClosed(t *testing.T, ch any, message ...any) error {
+	t.Helper()
+
+	return tryNotClosed(t, false, ch, message...)
+}
+
+// NotClosedNow tests whether the channel is not closed, and it'll terminate the execution if the
+// channel is closed and drained.
+//
+//	assert.NotClosedNow(t, make(chan int)) // success
+//	ch := make(chan int)
+//	close(ch)
+//	assert.NotClosedNow(t, ch) // fail and terminate
+//	// never runs
+func NotClosedNow(t *testing.T, ch any, message ...any) error {
+	t.Helper()
+
+	return tryNotClosed(t, true, ch, message...)
+}
+
+// ChannelLen tests whether the number of the buffered values in the channel is the expected
+// length, and it'll set the result to fail if the length is not the expected length.
+//
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	assert.ChannelLen(t, ch, 1) // success
+//	assert.ChannelLen(t, ch, 2) // fail
+func ChannelLen(t *testing.T, ch any, length int, message ...any) error {
+	t.Helper()
+
+	return tryChannelLen(t, false, ch, length, message...)
+}
+
+// ChannelLenNow tests whether the number of the buffered values in the channel is the expected
+// length, and it'll terminate the execution if the length is not the expected length.
+//
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	assert.ChannelLenNow(t, ch, 1) // success
+//	assert.ChannelLenNow(t, ch, 2) // fail and terminate
+//	// never runs
+func ChannelLenNow(t *testing.T, ch any, length int, message ...any) error {
+	t.Helper()
+
+	return tryChannelLen(t, true, ch, length, message...)
+}
+
+// ChannelYields drains the channel until it is closed, and tests whether the received values are
+// the expected values in order. It'll set the result to fail if the channel is not closed within
+// the duration, or the received values are not the expected values.
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//	assert.ChannelYields(t, ch, []int{1, 2}, time.Second) // success
+//	assert.ChannelYields(t, make(chan int), []int{}, 10*time.Millisecond) // fail
+func ChannelYields(t *testing.T, ch, expected any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryChannelYields(t, false, ch, expected, d, message...)
+}
+
+// ChannelYieldsNow drains the channel until it is closed, and tests whether the received values
+// are the expected values in order. It'll terminate the execution if the channel is not closed
+// within the duration, or the received values are not the expected values.
+//
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//	assert.ChannelYieldsNow(t, ch, []int{1, 2}, time.Second) // success
+//	assert.ChannelYieldsNow(t, make(chan int), []int{}, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func ChannelYieldsNow(t *testing.T, ch, expected any, d time.Duration, message ...any) error {
+	t.Helper()
+
+	return tryChannelYields(t, true, ch, expected, d, message...)
+}
+
+// HTTPStatus executes the handler with a request of the method, the url, and the body, and tests
+// whether the status code of the response is the expected code. It'll set the result to fail with
+// the response summary if the status code is not the expected code. The body can be nil if the
+// request has no body.
+//
+//	assert.HTTPStatus(t, handler, http.MethodGet, "/users/1", nil, http.StatusOK) // success
+//	assert.HTTPStatus(t, handler, http.MethodGet, "/not-found", nil, http.StatusOK) // fail
+func HTTPStatus(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	code int,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPStatus(t, false, handler, method, rawURL, body, code, message...)
+}
+
+// HTTPStatusNow executes the handler with a request of the method, the url, and the body, and tests
+// whether the status code of the response is the expected code. It'll terminate the execution if
+// the status code is not the expected code.
+//
+//	assert.HTTPStatusNow(t, handler, http.MethodGet, "/users/1", nil, http.StatusOK) // success
+//	assert.HTTPStatusNow(t, handler, http.MethodGet, "/not-found", nil, http.StatusOK) // fail and terminate
+//	// never runs
+func HTTPStatusNow(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	code int,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPStatus(t, true, handler, method, rawURL, body, code, message...)
+}
+
+// HTTPHeader executes the handler with a request of the method, the url, and the body, and tests
+// whether the response has the header with the expected value. It'll set the result to fail with
+// the response summary if no value of the header is the expected value.
+//
+//	assert.HTTPHeader(t, handler, http.MethodGet, "/", nil, "Content-Type", "text/plain") // success
+//	assert.HTTPHeader(t, handler, http.MethodGet, "/", nil, "Content-Type", "text/html") // fail
+func HTTPHeader(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	key, value string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPHeader(t, false, handler, method, rawURL, body, key, value, message...)
+}
+
+// HTTPHeaderNow executes the handler with a request of the method, the url, and the body, and tests
+// whether the response has the header with the expected value. It'll terminate the execution if
+// no value of the header is the expected value.
+//
+//	assert.HTTPHeaderNow(t, handler, http.MethodGet, "/", nil, "Content-Type", "text/plain") // success
+//	assert.HTTPHeaderNow(t, handler, http.MethodGet, "/", nil, "Content-Type", "text/html") // fail and terminate
+//	// never runs
+func HTTPHeaderNow(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	key, value string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPHeader(t, true, handler, method, rawURL, body, key, value, message...)
+}
+
+// HTTPBodyContains executes the handler with a request of the method, the url, and the body, and
+// tests whether the body of the response contains the substring. It'll set the result to fail with
+// the response summary if the body does not contain the substring.
+//
+//	assert.HTTPBodyContains(t, handler, http.MethodGet, "/", nil, "Hello") // success
+//	assert.HTTPBodyContains(t, handler, http.MethodGet, "/", nil, "Goodbye") // fail
+func HTTPBodyContains(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPBodyContains(t, false, handler, method, rawURL, body, substr, message...)
+}
+
+// HTTPBodyContainsNow executes the handler with a request of the method, the url, and the body,
+// and tests whether the body of the response contains the substring. It'll terminate the execution
+// if the body does not contain the substring.
+//
+//	assert.HTTPBodyContainsNow(t, handler, http.MethodGet, "/", nil, "Hello") // success
+//	assert.HTTPBodyContainsNow(t, handler, http.MethodGet, "/", nil, "Goodbye") // fail and terminate
+//	// never runs
+func HTTPBodyContainsNow(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPBodyContains(t, true, handler, method, rawURL, body, substr, message...)
+}
+
+// HTTPBodyJSONEqual executes the handler with a request of the method, the url, and the body, and
+// tests whether the body of the response is a JSON that equals to the expected value. The expected
+// value can be a JSON string, a JSON byte slice, or any value that can be encoded to JSON. It'll
+// set the result to fail with the response summary if the body is not the same JSON as the
+// expected value, and the order of the object keys and the whitespaces are ignored.
+//
+//	assert.HTTPBodyJSONEqual(t, handler, http.MethodGet, "/users/1", nil, `{"id":1}`) // success
+//	assert.HTTPBodyJSONEqual(t, handler, http.MethodGet, "/users/1", nil, map[string]int{"id": 1}) // success
+//	assert.HTTPBodyJSONEqual(t, handler, http.MethodGet, "/users/1", nil, `{"id":2}`) // fail
+func HTTPBodyJSONEqual(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	expected any,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPBodyJSONEqual(t, false, handler, method, rawURL, body, expected, message...)
+}
+
+// HTTPBodyJSONEqualNow executes the handler with a request of the method, the url, and the body,
+// and tests whether the body of the response is a JSON that equals to the expected value. It'll
+// terminate the execution if the body is not the same JSON as the expected value.
+//
+//	assert.HTTPBodyJSONEqualNow(t, handler, http.MethodGet, "/users/1", nil, `{"id":1}`) // success
+//	assert.HTTPBodyJSONEqualNow(t, handler, http.MethodGet, "/users/1", nil, `{"id":2}`) // fail and terminate
+//	// never runs
+func HTTPBodyJSONEqualNow(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	expected any,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPBodyJSONEqual(t, true, handler, method, rawURL, body, expected, message...)
+}
+
+// HTTPRedirectsTo executes the handler with a request of the method, the url, and the body, and
+// tests whether the response is a redirection to the expected location. It'll set the result to
+// fail with the response summary if the status code is not 3xx, or the location is not the
+// expected location. The relative locations are resolved by the request url before comparing.
+//
+//	assert.HTTPRedirectsTo(t, handler, http.MethodGet, "/old", nil, "/new") // success
+//	assert.HTTPRedirectsTo(t, handler, http.MethodGet, "/new", nil, "/old") // fail
+func HTTPRedirectsTo(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	location string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPRedirectsTo(t, false, handler, method, rawURL, body, location, message...)
+}
+
+// HTTPRedirectsToNow executes the handler with a request of the method, the url, and the body, and
+// tests whether the response is a redirection to the expected location. It'll terminate the
+// execution if the status code is not 3xx, or the location is not the expected location.
+//
+//	assert.HTTPRedirectsToNow(t, handler, http.MethodGet, "/old", nil, "/new") // success
+//	assert.HTTPRedirectsToNow(t, handler, http.MethodGet, "/new", nil, "/old") // fail and terminate
+//	// never runs
+func HTTPRedirectsToNow(
+	t *testing.T,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	location string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryHTTPRedirectsTo(t, true, handler, method, rawURL, body, location, message...)
+}
+
+// NewHTTPServer starts and returns a recording HTTP test server, and the server will be closed
+// after the test completed. The requests that do not match any configured route will be responded
+// with 404 Not Found.
+//
+//	server := assert.NewHTTPServer(t)
+//	server.Handle(http.MethodGet, "/users/1", assert.HTTPResponse{Body: `{"id":1}`})
+//	client := NewClient(server.URL)
+//	// ...
+//	server.ReceivedRequest(http.MethodGet, "/users/1") // success
+func NewHTTPServer(t *testing.T) *HTTPServer {
+	return newHTTPServer(t)
+}
+
+// FileExists tests whether the file exists and is not a directory. The file will be opened from
+// the file system fsys, or the OS file system if fsys is nil. It'll set the result to fail if the
+// file does not exist, or it is a directory.
+//
+//	assert.FileExists(t, nil, "testdata/out.txt") // success
+//	assert.FileExists(t, fstest.MapFS{"a.txt": {}}, "a.txt") // success
+//	assert.FileExists(t, nil, "testdata") // fail
+func FileExists(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryFileExists(t, false, fsys, name, message...)
+}
+
+// FileExistsNow tests whether the file exists and is not a directory. It'll terminate the
+// execution if the file does not exist, or it is a directory.
+//
+//	assert.FileExistsNow(t, nil, "testdata/out.txt") // success
+//	assert.FileExistsNow(t, nil, "testdata") // fail and terminate
+//	// never runs
+func FileExistsNow(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryFileExists(t, true, fsys, name, message...)
+}
+
+// NoFileExists tests whether the file does not exist or it is a directory. The file will be opened
+// from the file system fsys, or the OS file system if fsys is nil. It'll set the result to fail if
+// the file exists and is not a directory, or it cannot tell whether the file exists, for example,
+// the permission is denied or the name is not a valid path of fsys.
+//
+//	assert.NoFileExists(t, nil, "testdata/not-exist.txt") // success
+//	assert.NoFileExists(t, nil, "testdata/out.txt") // fail
+func NoFileExists(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryNoFileExists(t, false, fsys, name, message...)
+}
+
+// NoFileExistsNow tests whether the file does not exist or it is a directory. It'll terminate the
+// execution if the file exists and is not a directory, or it cannot tell whether the file exists.
+//
+//	assert.NoFileExistsNow(t, nil, "testdata/not-exist.txt") // success
+//	assert.NoFileExistsNow(t, nil, "testdata/out.txt") // fail and terminate
+//	// never runs
+func NoFileExistsNow(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryNoFileExists(t, true, fsys, name, message...)
+}
+
+// DirExists tests whether the directory exists. The directory will be opened from the file system
+// fsys, or the OS file system if fsys is nil. It'll set the result to fail if the directory does
+// not exist, or it is not a directory.
+//
+//	assert.DirExists(t, nil, "testdata") // success
+//	assert.DirExists(t, nil, "testdata/out.txt") // fail
+func DirExists(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryDirExists(t, false, fsys, name, message...)
+}
+
+// DirExistsNow tests whether the directory exists. It'll terminate the execution if the directory
+// does not exist, or it is not a directory.
+//
+//	assert.DirExistsNow(t, nil, "testdata") // success
+//	assert.DirExistsNow(t, nil, "testdata/out.txt") // fail and terminate
+//	// never runs
+func DirExistsNow(t *testing.T, fsys fs.FS, name string, message ...any) error {
+	t.Helper()
+
+	return tryDirExists(t, true, fsys, name, message...)
+}
+
+// FileMode tests whether the mode of the file is the expected mode, including the type bits and
+// the permission bits. The file will be opened from the file system fsys, or the OS file system
+// if fsys is nil. It'll set the result to fail if the file does not exist, or the mode is not the
+// expected mode.
+//
+//	assert.FileMode(t, nil, "testdata/out.txt", 0644) // success
+//	assert.FileMode(t, nil, "testdata", fs.ModeDir|0755) // success
+//	assert.FileMode(t, nil, "testdata/out.txt", 0755) // fail
+func FileMode(t *testing.T, fsys fs.FS, name string, mode fs.FileMode, message ...any) error {
+	t.Helper()
+
+	return tryFileMode(t, false, fsys, name, mode, message...)
+}
+
+// FileModeNow tests whether the mode of the file is the expected mode, including the type bits and
+// the permission bits. It'll terminate the execution if the file does not exist, or the mode is
+// not the expected mode.
+//
+//	assert.FileModeNow(t, nil, "testdata/out.txt", 0644) // success
+//	assert.FileModeNow(t, nil, "testdata/out.txt", 0755) // fail and terminate
+//	// never runs
+func FileModeNow(t *testing.T, fsys fs.FS, name string, mode fs.FileMode, message ...any) error {
+	t.Helper()
+
+	return tryFileMode(t, true, fsys, name, mode, message...)
+}
+
+// FileContentEqual tests whether the content of the file is the expected content. The file will
+// be read from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail with the diff of the contents if the file cannot be read, or the content is not the
+// expected content.
+//
+//	assert.FileContentEqual(t, nil, "testdata/out.txt", "Hello world\n") // success
+//	assert.FileContentEqual(t, nil, "testdata/out.txt", "Hello\n") // fail
+func FileContentEqual(t *testing.T, fsys fs.FS, name, expected string, message ...any) error {
+	t.Helper()
+
+	return tryFileContentEqual(t, false, fsys, name, expected, message...)
+}
+
+// FileContentEqualNow tests whether the content of the file is the expected content. It'll
+// terminate the execution if the file cannot be read, or the content is not the expected content.
+//
+//	assert.FileContentEqualNow(t, nil, "testdata/out.txt", "Hello world\n") // success
+//	assert.FileContentEqualNow(t, nil, "testdata/out.txt", "Hello\n") // fail and terminate
+//	// never runs
+func FileContentEqualNow(t *testing.T, fsys fs.FS, name, expected string, message ...any) error {
+	t.Helper()
+
+	return tryFileContentEqual(t, true, fsys, name, expected, message...)
+}
+
+// FileContains tests whether the content of the file contains the substring. The file will be
+// read from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail if the file cannot be read, or the content does not contain the substring.
+//
+//	assert.FileContains(t, nil, "testdata/out.txt", "Hello") // success
+//	assert.FileContains(t, nil, "testdata/out.txt", "Goodbye") // fail
+func FileContains(t *testing.T, fsys fs.FS, name, substr string, message ...any) error {
+	t.Helper()
+
+	return tryFileContains(t, false, fsys, name, substr, message...)
+}
+
+// FileContainsNow tests whether the content of the file contains the substring. It'll terminate
+// the execution if the file cannot be read, or the content does not contain the substring.
+//
+//	assert.FileContainsNow(t, nil, "testdata/out.txt", "Hello") // success
+//	assert.FileContainsNow(t, nil, "testdata/out.txt", "Goodbye") // fail and terminate
+//	// never runs
+func FileContainsNow(t *testing.T, fsys fs.FS, name, substr string, message ...any) error {
+	t.Helper()
+
+	return tryFileContains(t, true, fsys, name, substr, message...)
+}
+
+// FileMatches tests whether the content of the file matches the regular expression pattern. The
+// file will be read from the file system fsys, or the OS file system if fsys is nil. It'll set the
+// result to fail if the file cannot be read, or the content does not match the pattern. It'll
+// panic if the pattern is not a valid regular expression.
+//
+//	assert.FileMatches(t, nil, "testdata/out.txt", `^Hello \w+`) // success
+//	assert.FileMatches(t, nil, "testdata/out.txt", `^Goodbye`) // fail
+func FileMatches(t *testing.T, fsys fs.FS, name, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryFileMatches(t, false, fsys, name, pattern, message...)
+}
+
+// FileMatchesNow tests whether the content of the file matches the regular expression pattern.
+// It'll terminate the execution if the file cannot be read, or the content does not match the
+// pattern.
+//
+//	assert.FileMatchesNow(t, nil, "testdata/out.txt", `^Hello \w+`) // success
+//	assert.FileMatchesNow(t, nil, "testdata/out.txt", `^Goodbye`) // fail and terminate
+//	// never runs
+func FileMatchesNow(t *testing.T, fsys fs.FS, name, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryFileMatches(t, true, fsys, name, pattern, message...)
+}
+
+// FileSize tests whether the size of the file in bytes is the expected size. The file will be
+// opened from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail if the file does not exist, or the size is not the expected size.
+//
+//	assert.FileSize(t, nil, "testdata/out.txt", 12) // success
+//	assert.FileSize(t, nil, "testdata/out.txt", 0) // fail
+func FileSize(t *testing.T, fsys fs.FS, name string, size int64, message ...any) error {
+	t.Helper()
+
+	return tryFileSize(t, false, fsys, name, size, message...)
+}
+
+// FileSizeNow tests whether the size of the file in bytes is the expected size. It'll terminate
+// the execution if the file does not exist, or the size is not the expected size.
+//
+//	assert.FileSizeNow(t, nil, "testdata/out.txt", 12) // success
+//	assert.FileSizeNow(t, nil, "testdata/out.txt", 0) // fail and terminate
+//	// never runs
+func FileSizeNow(t *testing.T, fsys fs.FS, name string, size int64, message ...any) error {
+	t.Helper()
+
+	return tryFileSize(t, true, fsys, name, size, message...)
+}
+
+// DirEqual tests whether the directory trees are the same, including the file paths, the file
+// modes, and the file contents. The directories can be the paths in the OS file system or the
+// `fs.FS` instances, and the empty directories are not compared. It'll set the result to fail
+// with the missing files, the extra files, the mode differences, and the content diffs if the
+// directory trees are not the same. It'll panic if any directory is not a path or an `fs.FS`.
+//
+//	assert.DirEqual(t, outputDir, "testdata/golden") // success
+//	assert.DirEqual(t, os.DirFS(outputDir), "testdata/other") // fail
+func DirEqual(t *testing.T, actual, expected any, message ...any) error {
+	t.Helper()
+
+	return tryDirEqual(t, false, actual, expected, DirEqualOptions{}, message...)
+}
+
+// DirEqualNow tests whether the directory trees are the same, including the file paths, the file
+// modes, and the file contents. It'll terminate the execution if the directory trees are not the
+// same.
+//
+//	assert.DirEqualNow(t, outputDir, "testdata/golden") // success
+//	assert.DirEqualNow(t, outputDir, "testdata/other") // fail and terminate
+//	// never runs
+func DirEqualNow(t *testing.T, actual, expected any, message ...any) error {
+	t.Helper()
+
+	return tryDirEqual(t, true, actual, expected, DirEqualOptions{}, message...)
+}
+
+// DirEqualWith tests whether the directory trees are the same with the options. It'll set the
+// result to fail with the differences if the directory trees are not the same. In the update
+// mode, it'll rewrite the expected directory with the actual directory and never fail.
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	assert.DirEqualWith(t, outputDir, "testdata/golden", assert.DirEqualOptions{
+//	  Ignores: []string{"*.log"},
+//	  Update:  *update,
+//	}) // success
+func DirEqualWith(
+	t *testing.T,
+	actual, expected any,
+	options DirEqualOptions,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryDirEqual(t, false, actual, expected, options, message...)
+}
+
+// DirEqualWithNow tests whether the directory trees are the same with the options. It'll terminate
+// the execution if the directory trees are not the same.
+//
+//	assert.DirEqualWithNow(t, outputDir, "testdata/golden", assert.DirEqualOptions{
+//	  IgnoreMode: true,
+//	}) // success
+//	assert.DirEqualWithNow(t, outputDir, "testdata/other", assert.DirEqualOptions{}) // fail and terminate
+//	// never runs
+func DirEqualWithNow(
+	t *testing.T,
+	actual, expected any,
+	options DirEqualOptions,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryDirEqual(t, true, actual, expected, options, message...)
+}
+
+// ReaderEqual tests whether the contents of the readers are the same. It reads and compares the
+// readers in chunks, and stops at the first difference without reading the rest of the readers.
+// It'll set the result to fail with the byte offset, the line and column, and the text or the
+// hexdump around the first difference if the contents are not the same, or any reader returns an
+// error.
+//
+//	assert.ReaderEqual(t, strings.NewReader("Hello"), strings.NewReader("Hello")) // success
+//	assert.ReaderEqual(t, strings.NewReader("Hello"), strings.NewReader("World")) // fail
+func ReaderEqual(t *testing.T, actual, expected io.Reader, message ...any) error {
+	t.Helper()
+
+	return tryReaderEqual(t, false, actual, expected, message...)
+}
+
+// ReaderEqualNow tests whether the contents of the readers are the same. It'll terminate the
+// execution if the contents are not the same, or any reader returns an error.
+//
+//	assert.ReaderEqualNow(t, strings.NewReader("Hello"), strings.NewReader("Hello")) // success
+//	assert.ReaderEqualNow(t, strings.NewReader("Hello"), strings.NewReader("World")) // fail and terminate
+//	// never runs
+func ReaderEqualNow(t *testing.T, actual, expected io.Reader, message ...any) error {
+	t.Helper()
+
+	return tryReaderEqual(t, true, actual, expected, message...)
+}
+
+// ReaderEqualBytes tests whether the content of the reader is the expected bytes. It reads and
+// compares the reader in chunks, and stops at the first difference. It'll set the result to fail
+// with the byte offset, the line and column, and the text or the hexdump around the first
+// difference if the content is not the expected bytes, or the reader returns an error.
+//
+//	assert.ReaderEqualBytes(t, strings.NewReader("Hello"), []byte("Hello")) // success
+//	assert.ReaderEqualBytes(t, strings.NewReader("Hello"), []byte("World")) // fail
+func ReaderEqualBytes(t *testing.T, r io.Reader, expected []byte, message ...any) error {
+	t.Helper()
+
+	return tryReaderEqual(t, false, r, bytes.NewReader(expected), message...)
+}
+
+// ReaderEqualBytesNow tests whether the content of the reader is the expected bytes. It'll
+// terminate the execution if the content is not the expected bytes, or the reader returns an
+// error.
+//
+//	assert.ReaderEqualBytesNow(t, strings.NewReader("Hello"), []byte("Hello")) // success
+//	assert.ReaderEqualBytesNow(t, strings.NewReader("Hello"), []byte("World")) // fail and terminate
+//	// never runs
+func ReaderEqualBytesNow(t *testing.T, r io.Reader, expected []byte, message ...any) error {
+	t.Helper()
+
+	return tryReaderEqual(t, true, r, bytes.NewReader(expected), message...)
+}
+
+// TextEqual tests whether the text is the expected text. It'll set the result to fail with the
+// line-based diff of the texts, and the invisible differences like the trailing whitespaces, the
+// tabs and spaces, and the line terminators will be visualized in the diff.
+//
+//	assert.TextEqual(t, "Hello\nworld\n", "Hello\nworld\n") // success
+//	assert.TextEqual(t, "Hello\nworld\n", "Hello\nthere\n") // fail
+//	assert.TextEqual(t, "Hello\r\nworld\n", "Hello\nworld\n") // fail
+func TextEqual(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryTextEqual(t, false, actual, expected, message...)
+}
+
+// TextEqualNow tests whether the text is the expected text. It'll terminate the execution with
+// the line-based diff of the texts if the text is not the expected text.
+//
+//	assert.TextEqualNow(t, "Hello\nworld\n", "Hello\nworld\n") // success
+//	assert.TextEqualNow(t, "Hello\nworld\n", "Hello\nthere\n") // fail and terminate
+//	// never runs
+func TextEqualNow(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryTextEqual(t, true, actual, expected, message...)
+}
+
+// EqualFold tests whether the strings are equal under Unicode case-folding. It'll set the result to
+// fail with the case-folded forms of the strings if they are not equal ignoring case.
+//
+//	assert.EqualFold(t, "Hello World", "hello world") // success
+//	assert.EqualFold(t, "Hello World", "HELLO WORLD") // success
+//	assert.EqualFold(t, "Hello World", "Hello there") // fail
+func EqualFold(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, false, actual, expected, foldString, defaultErrMessageEqualFold, message...,
+	)
+}
+
+// EqualFoldNow tests whether the strings are equal under Unicode case-folding. It'll terminate the
+// execution if they are not equal ignoring case.
+//
+//	assert.EqualFoldNow(t, "Hello World", "hello world") // success
+//	assert.EqualFoldNow(t, "Hello World", "Hello there") // fail and terminate
+//	// never runs
+func EqualFoldNow(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, true, actual, expected, foldString, defaultErrMessageEqualFold, message...,
+	)
+}
+
+// EqualIgnoringWhitespace tests whether the strings are equal after collapsing every run of
+// whitespace characters into a single space, and trimming the leading and trailing whitespaces.
+// It'll set the result to fail with the normalized forms of the strings if they are not equal.
+//
+//	assert.EqualIgnoringWhitespace(t, "SELECT *\n  FROM users", "SELECT * FROM users") // success
+//	assert.EqualIgnoringWhitespace(t, " Hello\tworld ", "Hello world") // success
+//	assert.EqualIgnoringWhitespace(t, "Helloworld", "Hello world") // fail
+func EqualIgnoringWhitespace(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, false, actual, expected, collapseWhitespaces, defaultErrMessageEqualIgnoreSpace, message...,
+	)
+}
+
+// EqualIgnoringWhitespaceNow tests whether the strings are equal after collapsing the whitespaces.
+// It'll terminate the execution if they are not equal.
+//
+//	assert.EqualIgnoringWhitespaceNow(t, "SELECT *\n  FROM users", "SELECT * FROM users") // success
+//	assert.EqualIgnoringWhitespaceNow(t, "Helloworld", "Hello world") // fail and terminate
+//	// never runs
+func EqualIgnoringWhitespaceNow(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, true, actual, expected, collapseWhitespaces, defaultErrMessageEqualIgnoreSpace, message...,
+	)
+}
+
+// EqualNormalizedNewlines tests whether the strings are equal after converting the line
+// terminators "\r\n" and "\r" to "\n". It'll set the result to fail with the normalized forms of
+// the strings if they are not equal.
+//
+//	assert.EqualNormalizedNewlines(t, "Hello\r\nworld\r\n", "Hello\nworld\n") // success
+//	assert.EqualNormalizedNewlines(t, "Hello\r\nworld", "Hello\nworld\n") // fail
+func EqualNormalizedNewlines(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, false, actual, expected, normalizeNewlines, defaultErrMessageEqualNewlines, message...,
+	)
+}
+
+// EqualNormalizedNewlinesNow tests whether the strings are equal after normalizing the line
+// terminators. It'll terminate the execution if they are not equal.
+//
+//	assert.EqualNormalizedNewlinesNow(t, "Hello\r\nworld\r\n", "Hello\nworld\n") // success
+//	assert.EqualNormalizedNewlinesNow(t, "Hello\r\nworld", "Hello\nworld\n") // fail and terminate
+//	// never runs
+func EqualNormalizedNewlinesNow(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, true, actual, expected, normalizeNewlines, defaultErrMessageEqualNewlines, message...,
+	)
+}
+
+// EqualIgnoringIndentation tests whether the strings are equal after removing the leading spaces
+// and tabs of every line. It'll set the result to fail with the normalized forms of the strings if
+// they are not equal.
+//
+//	assert.EqualIgnoringIndentation(t, "<p>\n  Hi\n</p>", "<p>\nHi\n</p>") // success
+//	assert.EqualIgnoringIndentation(t, "<p>\n  Hi\n</p>", "<p>Hi</p>") // fail
+func EqualIgnoringIndentation(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, false, actual, expected, removeIndentation, defaultErrMessageEqualIgnoreIndent, message...,
+	)
+}
+
+// EqualIgnoringIndentationNow tests whether the strings are equal after removing the indentation
+// of every line. It'll terminate the execution if they are not equal.
+//
+//	assert.EqualIgnoringIndentationNow(t, "<p>\n  Hi\n</p>", "<p>\nHi\n</p>") // success
+//	assert.EqualIgnoringIndentationNow(t, "<p>\n  Hi\n</p>", "<p>Hi</p>") // fail and terminate
+//	// never runs
+func EqualIgnoringIndentationNow(t *testing.T, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return tryNormalizedEqual(
+		t, true, actual, expected, removeIndentation, defaultErrMessageEqualIgnoreIndent, message...,
+	)
+}
+
+// ContainsStringFold tests whether the string contains the substring under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// contain the substring ignoring case.
+//
+//	assert.ContainsStringFold(t, "Hello World", "world") // success
+//	assert.ContainsStringFold(t, "Hello World", "O W") // success
+//	assert.ContainsStringFold(t, "Hello World", "there") // fail
+func ContainsStringFold(t *testing.T, str, substr string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, false, str, substr, strings.Contains, defaultErrMessageContainsFold, message...,
+	)
+}
+
+// ContainsStringFoldNow tests whether the string contains the substring under Unicode
+// case-folding. It'll terminate the execution if the string does not contain the substring
+// ignoring case.
+//
+//	assert.ContainsStringFoldNow(t, "Hello World", "world") // success
+//	assert.ContainsStringFoldNow(t, "Hello World", "there") // fail and terminate
+//	// never runs
+func ContainsStringFoldNow(t *testing.T, str, substr string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, true, str, substr, strings.Contains, defaultErrMessageContainsFold, message...,
+	)
+}
+
+// HasPrefixStringFold tests whether the string has the prefix string under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// have the prefix ignoring case.
+//
+//	assert.HasPrefixStringFold(t, "Hello World", "hello") // success
+//	assert.HasPrefixStringFold(t, "Hello World", "world") // fail
+func HasPrefixStringFold(t *testing.T, str, prefix string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, false, str, prefix, strings.HasPrefix, defaultErrMessageHasPrefixFold, message...,
+	)
+}
+
+// HasPrefixStringFoldNow tests whether the string has the prefix string under Unicode
+// case-folding. It'll terminate the execution if the string does not have the prefix ignoring
+// case.
+//
+//	assert.HasPrefixStringFoldNow(t, "Hello World", "hello") // success
+//	assert.HasPrefixStringFoldNow(t, "Hello World", "world") // fail and terminate
+//	// never runs
+func HasPrefixStringFoldNow(t *testing.T, str, prefix string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, true, str, prefix, strings.HasPrefix, defaultErrMessageHasPrefixFold, message...,
+	)
+}
+
+// HasSuffixStringFold tests whether the string has the suffix string under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// have the suffix ignoring case.
+//
+//	assert.HasSuffixStringFold(t, "Hello World", "WORLD") // success
+//	assert.HasSuffixStringFold(t, "Hello World", "hello") // fail
+func HasSuffixStringFold(t *testing.T, str, suffix string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, false, str, suffix, strings.HasSuffix, defaultErrMessageHasSuffixFold, message...,
+	)
+}
+
+// HasSuffixStringFoldNow tests whether the string has the suffix string under Unicode
+// case-folding. It'll terminate the execution if the string does not have the suffix ignoring
+// case.
+//
+//	assert.HasSuffixStringFoldNow(t, "Hello World", "WORLD") // success
+//	assert.HasSuffixStringFoldNow(t, "Hello World", "hello") // fail and terminate
+//	// never runs
+func HasSuffixStringFoldNow(t *testing.T, str, suffix string, message ...any) error {
+	t.Helper()
+
+	return tryStringFold(
+		t, true, str, suffix, strings.HasSuffix, defaultErrMessageHasSuffixFold, message...,
+	)
+}
+
+// ContainsAllStrings tests whether the string contains all the substrings, and it set the result
+// to fail with the missing substrings if the string does not contain any of them.
+//
+//	assert.ContainsAllStrings(t, "Hello world", []string{"Hello", "world"}) // success
+//	assert.ContainsAllStrings(t, "Hello world", []string{"Hello", "there"}) // fail
+func ContainsAllStrings(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsAllStrings(t, false, str, substrs, message...)
+}
+
+// ContainsAllStringsNow tests whether the string contains all the substrings, and it will
+// terminate the execution if the string does not contain any of them.
+//
+//	assert.ContainsAllStringsNow(t, "Hello world", []string{"Hello", "world"}) // success
+//	assert.ContainsAllStringsNow(t, "Hello world", []string{"Hello", "there"}) // fail and terminate
+//	// never runs
+func ContainsAllStringsNow(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsAllStrings(t, true, str, substrs, message...)
+}
+
+// ContainsAnyString tests whether the string contains at least one of the substrings, and it set
+// the result to fail if the string contains none of them.
+//
+//	assert.ContainsAnyString(t, "Hello world", []string{"there", "world"}) // success
+//	assert.ContainsAnyString(t, "Hello world", []string{"there", "hi"}) // fail
+func ContainsAnyString(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsAnyString(t, false, str, substrs, message...)
+}
+
+// ContainsAnyStringNow tests whether the string contains at least one of the substrings, and it
+// will terminate the execution if the string contains none of them.
+//
+//	assert.ContainsAnyStringNow(t, "Hello world", []string{"there", "world"}) // success
+//	assert.ContainsAnyStringNow(t, "Hello world", []string{"there", "hi"}) // fail and terminate
+//	// never runs
+func ContainsAnyStringNow(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsAnyString(t, true, str, substrs, message...)
+}
+
+// ContainsNoneStrings tests whether the string contains none of the substrings, and it set the
+// result to fail with the found substrings and their offsets if the string contains any of them.
+//
+//	assert.ContainsNoneStrings(t, "Hello world", []string{"there", "hi"}) // success
+//	assert.ContainsNoneStrings(t, "Hello world", []string{"there", "world"}) // fail
+func ContainsNoneStrings(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsNoneStrings(t, false, str, substrs, message...)
+}
+
+// ContainsNoneStringsNow tests whether the string contains none of the substrings, and it will
+// terminate the execution if the string contains any of them.
+//
+//	assert.ContainsNoneStringsNow(t, "Hello world", []string{"there", "hi"}) // success
+//	assert.ContainsNoneStringsNow(t, "Hello world", []string{"there", "world"}) // fail and terminate
+//	// never runs
+func ContainsNoneStringsNow(t *testing.T, str string, substrs []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsNoneStrings(t, true, str, substrs, message...)
+}
+
+// StringCount tests whether the number of the non-overlapping occurrences of the substring in the
+// string equals to n, and it set the result to fail if the number is not n.
+//
+//	assert.StringCount(t, "cheese", "e", 3) // success
+//	assert.StringCount(t, "cheese", "e", 2) // fail
+func StringCount(t *testing.T, str, substr string, n int, message ...any) error {
+	t.Helper()
+
+	return tryStringCount(t, false, str, substr, n, message...)
+}
+
+// StringCountNow tests whether the number of the non-overlapping occurrences of the substring in
+// the string equals to n, and it will terminate the execution if the number is not n.
+//
+//	assert.StringCountNow(t, "cheese", "e", 3) // success
+//	assert.StringCountNow(t, "cheese", "e", 2) // fail and terminate
+//	// never runs
+func StringCountNow(t *testing.T, str, substr string, n int, message ...any) error {
+	t.Helper()
+
+	return tryStringCount(t, true, str, substr, n, message...)
+}
+
+// ContainsInOrder tests whether the parts appear in the string in sequence without overlapping,
+// and it set the result to fail with the first missing or out of order part, and the offset of the
+// last matched part.
+//
+//	assert.ContainsInOrder(t, "step 1, step 2, step 3", []string{"1", "2", "3"}) // success
+//	assert.ContainsInOrder(t, "step 1, step 2, step 3", []string{"1", "3", "2"}) // fail
+func ContainsInOrder(t *testing.T, str string, parts []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsInOrder(t, false, str, parts, message...)
+}
+
+// ContainsInOrderNow tests whether the parts appear in the string in sequence without
+// overlapping, and it will terminate the execution if any part is missing or out of order.
+//
+//	assert.ContainsInOrderNow(t, "step 1, step 2, step 3", []string{"1", "2", "3"}) // success
+//	assert.ContainsInOrderNow(t, "step 1, step 2, step 3", []string{"1", "3", "2"}) // fail and terminate
+//	// never runs
+func ContainsInOrderNow(t *testing.T, str string, parts []string, message ...any) error {
+	t.Helper()
+
+	return tryContainsInOrder(t, true, str, parts, message...)
+}
+
+// FullMatch tests whether the whole string matches the regular expression, and it set the result
+// to fail if the pattern does not match the entire string.
+//
+//	pattern := regexp.MustCompile(`[a-z]+`)
+//	assert.FullMatch(t, "hello", pattern) // success
+//	assert.FullMatch(t, "hello world", pattern) // fail
+func FullMatch(t *testing.T, val string, pattern *regexp.Regexp, message ...any) error {
+	t.Helper()
+
+	return tryFullMatch(t, false, val, pattern, "", message...)
+}
+
+// FullMatchNow tests whether the whole string matches the regular expression, and it will
+// terminate the execution if the pattern does not match the entire string.
+//
+//	pattern := regexp.MustCompile(`[a-z]+`)
+//	assert.FullMatchNow(t, "hello", pattern) // success
+//	assert.FullMatchNow(t, "hello world", pattern) // fail and terminate
+//	// never runs
+func FullMatchNow(t *testing.T, val string, pattern *regexp.Regexp, message ...any) error {
+	t.Helper()
+
+	return tryFullMatch(t, true, val, pattern, "", message...)
+}
+
+// FullMatchString will compile the pattern and test whether the whole string matches the regular
+// expression. It will panic if the pattern is not a valid regular expression.
+//
+//	assert.FullMatchString(t, "hello", `[a-z]+`) // success
+//	assert.FullMatchString(t, "hello world", `[a-z]+`) // fail
+func FullMatchString(t *testing.T, val, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryFullMatch(t, false, val, nil, pattern, message...)
+}
+
+// FullMatchStringNow will compile the pattern and test whether the whole string matches the
+// regular expression. It will terminate the execution if the pattern does not match the entire
+// string, and it will panic if the pattern is not a valid regular expression.
+//
+//	assert.FullMatchStringNow(t, "hello", `[a-z]+`) // success
+//	assert.FullMatchStringNow(t, "hello world", `[a-z]+`) // fail and terminate
+//	// never runs
+func FullMatchStringNow(t *testing.T, val, pattern string, message ...any) error {
+	t.Helper()
+
+	return tryFullMatch(t, true, val, nil, pattern, message...)
+}
+
+// MatchCount tests whether the number of the non-overlapping matches of the regular expression in
+// the string equals to n, and it set the result to fail if the number is not n.
+//
+//	pattern := regexp.MustCompile(`\d+`)
+//	assert.MatchCount(t, "1, 22, 333", pattern, 3) // success
+//	assert.MatchCount(t, "1, 22, 333", pattern, 2) // fail
+func MatchCount(t *testing.T, val string, pattern *regexp.Regexp, n int, message ...any) error {
+	t.Helper()
+
+	return tryMatchCount(t, false, val, pattern, n, message...)
+}
+
+// MatchCountNow tests whether the number of the non-overlapping matches of the regular expression
+// in the string equals to n, and it will terminate the execution if the number is not n.
+//
+//	pattern := regexp.MustCompile(`\d+`)
+//	assert.MatchCountNow(t, "1, 22, 333", pattern, 3) // success
+//	assert.MatchCountNow(t, "1, 22, 333", pattern, 2) // fail and terminate
+//	// never runs
+func MatchCountNow(
+	t *testing.T,
+	val string,
+	pattern *regexp.Regexp,
+	n int,
+	message ...any,
+) error {
+	t.Helper()
+
+	return tryMatchCount(t, true, val, pattern, n, message...)
+}
+
+// MatchGroups tests whether the string matches the regular expression, and the capture groups of
+// the first match are the expected values. The keys of the expected values are the names or the
+// numbers of the groups, and the groups that are not in the expected values are not checked. It
+// returns the submatches of the first match, and the index 0 is the text of the whole match. It'll
+// set the result to fail if the string does not match, or any group is not the expected value.
+//
+//	pattern := regexp.MustCompile(`(?P<year>\d{4})-(\d{2})`)
+//	groups, _ := assert.MatchGroups(t, "2024-05", pattern, map[string]string{
+//	  "year": "2024",
+//	  "2":    "05",
+//	}) // success, groups is ["2024-05", "2024", "05"]
+//	assert.MatchGroups(t, "2024-05", pattern, map[string]string{"year": "2023"}) // fail
+func MatchGroups(
+	t *testing.T,
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	message ...any,
+) ([]string, error) {
+	t.Helper()
+
+	return tryMatchGroups(t, false, val, pattern, expected, message...)
+}
+
+// MatchGroupsNow tests whether the string matches the regular expression, and the capture groups
+// of the first match are the expected values. It returns the submatches of the first match, and
+// it will terminate the execution if the string does not match, or any group is not the expected
+// value.
+//
+//	pattern := regexp.MustCompile(`(?P<year>\d{4})-(\d{2})`)
+//	assert.MatchGroupsNow(t, "2024-05", pattern, map[string]string{"year": "2024"}) // success
+//	assert.MatchGroupsNow(t, "2024-05", pattern, map[string]string{"year": "2023"}) // fail and terminate
+//	// never runs
+func MatchGroupsNow(
+	t *testing.T,
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	message ...any,
+) ([]string, error) {
+	t.Helper()
+
+	return tryMatchGroups(t, true, val, pattern, expected, message...)
+}
+
+// BytesEqual tests whether the byte slices are the same, and a nil slice equals to an empty slice.
+// It'll set the result to fail with the offset of the first different byte, and the side-by-side
+// hexdumps around it with the different bytes marked if they are not the same.
+//
+//	assert.BytesEqual(t, []byte{0x01, 0x02}, []byte{0x01, 0x02}) // success
+//	assert.BytesEqual(t, []byte{0x01, 0x02}, []byte{0x01, 0x03}) // fail
+func BytesEqual(t *testing.T, actual, expected []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesEqual(t, false, actual, expected, message...)
+}
+
+// BytesEqualNow tests whether the byte slices are the same, and it will terminate the execution
+// if they are not the same.
+//
+//	assert.BytesEqualNow(t, []byte{0x01, 0x02}, []byte{0x01, 0x02}) // success
+//	assert.BytesEqualNow(t, []byte{0x01, 0x02}, []byte{0x01, 0x03}) // fail and terminate
+//	// never runs
+func BytesEqualNow(t *testing.T, actual, expected []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesEqual(t, true, actual, expected, message...)
+}
+
+// BytesHasPrefix tests whether the byte slice begins with the prefix, and it set the result to
+// fail with the offset of the first different byte, and the side-by-side hexdumps of the prefix
+// and the beginning of the byte slice if it does not have the prefix.
+//
+//	assert.BytesHasPrefix(t, []byte{0xca, 0xfe, 0x01}, []byte{0xca, 0xfe}) // success
+//	assert.BytesHasPrefix(t, []byte{0xca, 0xfe, 0x01}, []byte{0xbe, 0xef}) // fail
+func BytesHasPrefix(t *testing.T, b, prefix []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesHasPrefix(t, false, b, prefix, message...)
+}
+
+// BytesHasPrefixNow tests whether the byte slice begins with the prefix, and it will terminate
+// the execution if it does not have the prefix.
+//
+//	assert.BytesHasPrefixNow(t, []byte{0xca, 0xfe, 0x01}, []byte{0xca, 0xfe}) // success
+//	assert.BytesHasPrefixNow(t, []byte{0xca, 0xfe, 0x01}, []byte{0xbe, 0xef}) // fail and terminate
+//	// never runs
+func BytesHasPrefixNow(t *testing.T, b, prefix []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesHasPrefix(t, true, b, prefix, message...)
+}
+
+// BytesContains tests whether the byte slice contains the sub-slice, and it set the result to fail
+// with the hexdumps of the sub-slice and the byte slice if it does not contain the sub-slice.
+//
+//	assert.BytesContains(t, []byte{0x01, 0x02, 0x03}, []byte{0x02, 0x03}) // success
+//	assert.BytesContains(t, []byte{0x01, 0x02, 0x03}, []byte{0x03, 0x02}) // fail
+func BytesContains(t *testing.T, b, sub []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesContains(t, false, b, sub, message...)
+}
+
+// BytesContainsNow tests whether the byte slice contains the sub-slice, and it will terminate the
+// execution if it does not contain the sub-slice.
+//
+//	assert.BytesContainsNow(t, []byte{0x01, 0x02, 0x03}, []byte{0x02, 0x03}) // success
+//	assert.BytesContainsNow(t, []byte{0x01, 0x02, 0x03}, []byte{0x03, 0x02}) // fail and terminate
+//	// never runs
+func BytesContainsNow(t *testing.T, b, sub []byte, message ...any) error {
+	t.Helper()
+
+	return tryBytesContains(t, true, b, sub, message...)
+}
+
+// BytesLen tests whether the length of the byte slice is n, and it set the result to fail if the
+// length is not n.
+//
+//	assert.BytesLen(t, []byte{0x01, 0x02}, 2) // success
+//	assert.BytesLen(t, []byte{0x01, 0x02}, 3) // fail
+func BytesLen(t *testing.T, b []byte, n int, message ...any) error {
+	t.Helper()
+
+	return tryBytesLen(t, false, b, n, message...)
+}
+
+// BytesLenNow tests whether the length of the byte slice is n, and it will terminate the
+// execution if the length is not n.
+//
+//	assert.BytesLenNow(t, []byte{0x01, 0x02}, 2) // success
+//	assert.BytesLenNow(t, []byte{0x01, 0x02}, 3) // fail and terminate
+//	// never runs
+func BytesLenNow(t *testing.T, b []byte, n int, message ...any) error {
+	t.Helper()
+
+	return tryBytesLen(t, true, b, n, message...)
+}
+
+// IsType tests whether the dynamic type of the value is the same as the type of the expected
+// sample, or the type if the sample is a `reflect.Type`. It'll set the result to fail with the
+// full names of the types, including the package paths, if the types are not the same.
+//
+//	assert.IsType(t, 1, 0) // success
+//	assert.IsType(t, &bytes.Buffer{}, (*bytes.Buffer)(nil)) // success
+//	assert.IsType(t, 1, int64(0)) // fail
+func IsType(t *testing.T, v, expectedSample any, message ...any) error {
+	t.Helper()
+
+	return tryIsType(t, false, v, expectedSample, message...)
+}
+
+// IsTypeNow tests whether the dynamic type of the value is the same as the type of the expected
+// sample, or the type if the sample is a `reflect.Type`. It'll terminate the execution if the
+// types are not the same.
+//
+//	assert.IsTypeNow(t, 1, 0) // success
+//	assert.IsTypeNow(t, 1, int64(0)) // fail and terminate
+//	// never runs
+func IsTypeNow(t *testing.T, v, expectedSample any, message ...any) error {
+	t.Helper()
+
+	return tryIsType(t, true, v, expectedSample, message...)
+}
+
+// IsKind tests whether the kind of the value is the expected kind, and it set the result to fail
+// if the kind is not the expected kind. The kind of nil is `reflect.Invalid`.
+//
+//	assert.IsKind(t, []int{1}, reflect.Slice) // success
+//	assert.IsKind(t, map[string]int{}, reflect.Slice) // fail
+func IsKind(t *testing.T, v any, kind reflect.Kind, message ...any) error {
+	t.Helper()
+
+	return tryIsKind(t, false, v, kind, message...)
+}
+
+// IsKindNow tests whether the kind of the value is the expected kind, and it will terminate the
+// execution if the kind is not the expected kind.
+//
+//	assert.IsKindNow(t, []int{1}, reflect.Slice) // success
+//	assert.IsKindNow(t, map[string]int{}, reflect.Slice) // fail and terminate
+//	// never runs
+func IsKindNow(t *testing.T, v any, kind reflect.Kind, message ...any) error {
+	t.Helper()
+
+	return tryIsKind(t, true, v, kind, message...)
+}
+
+// Implements tests whether the dynamic type of the value implements the interface, and the
+// interface is given by a nil pointer to it like `(*io.Reader)(nil)`. It'll set the result to fail
+// with the missing methods if the value does not implement the interface, and it'll panic if the
+// iface is not a pointer to an interface.
+//
+//	assert.Implements(t, &bytes.Buffer{}, (*io.Reader)(nil)) // success
+//	assert.Implements(t, bytes.Buffer{}, (*io.Reader)(nil)) // fail
+func Implements(t *testing.T, v, iface any, message ...any) error {
+	t.Helper()
+
+	return tryImplements(t, false, v, iface, message...)
+}
+
+// ImplementsNow tests whether the dynamic type of the value implements the interface, and the
+// interface is given by a nil pointer to it like `(*io.Reader)(nil)`. It'll terminate the
+// execution if the value does not implement the interface, and it'll panic if the iface is not a
+// pointer to an interface.
+//
+//	assert.ImplementsNow(t, &bytes.Buffer{}, (*io.Reader)(nil)) // success
+//	assert.ImplementsNow(t, bytes.Buffer{}, (*io.Reader)(nil)) // fail and terminate
+//	// never runs
+func ImplementsNow(t *testing.T, v, iface any, message ...any) error {
+	t.Helper()
+
+	return tryImplements(t, true, v, iface, message...)
+}
+
+// AssignableTo tests whether the value is assignable to the type of the sample, or the type if the
+// sample is a `reflect.Type`. It'll set the result to fail if the value is not assignable to the
+// type.
+//
+//	assert.AssignableTo(t, &bytes.Buffer{}, reflect.TypeOf((*io.Writer)(nil)).Elem()) // success
+//	assert.AssignableTo(t, 1, int64(0)) // fail
+func AssignableTo(t *testing.T, v, sample any, message ...any) error {
+	t.Helper()
+
+	return tryAssignableTo(t, false, v, sample, message...)
+}
+
+// AssignableToNow tests whether the value is assignable to the type of the sample, or the type if
+// the sample is a `reflect.Type`. It'll terminate the execution if the value is not assignable to
+// the type.
+//
+//	assert.AssignableToNow(t, &bytes.Buffer{}, reflect.TypeOf((*io.Writer)(nil)).Elem()) // success
+//	assert.AssignableToNow(t, 1, int64(0)) // fail and terminate
+//	// never runs
+func AssignableToNow(t *testing.T, v, sample any, message ...any) error {
+	t.Helper()
+
+	return tryAssignableTo(t, true, v, sample, message...)
+}
+
+// ConvertibleTo tests whether the value is convertible to the type of the sample, or the type if
+// the sample is a `reflect.Type`. It'll set the result to fail if the value is not convertible to
+// the type.
+//
+//	assert.ConvertibleTo(t, 1, int64(0)) // success
+//	assert.ConvertibleTo(t, "1", 0) // fail
+func ConvertibleTo(t *testing.T, v, sample any, message ...any) error {
+	t.Helper()
+
+	return tryConvertibleTo(t, false, v, sample, message...)
+}
+
+// ConvertibleToNow tests whether the value is convertible to the type of the sample, or the type
+// if the sample is a `reflect.Type`. It'll terminate the execution if the value is not
+// convertible to the type.
+//
+//	assert.ConvertibleToNow(t, 1, int64(0)) // success
+//	assert.ConvertibleToNow(t, "1", 0) // fail and terminate
+//	// never runs
+func ConvertibleToNow(t *testing.T, v, sample any, message ...any) error {
+	t.Helper()
+
+	return tryConvertibleTo(t, true, v, sample, message...)
+}
+
+// Zero tests whether the value is the zero value for its type, and it'll set the result to fail if
+// the value is not the zero value. Unlike True, it uses the `reflect.Value.IsZero` semantics
+// without special cases, so an empty but non-nil slice or map is not zero. For nil, the value is
+// always zero.
+//
+//	assert.Zero(t, 0) // success
+//	assert.Zero(t, []int(nil)) // success
+//	assert.Zero(t, 1) // fail
+//	assert.Zero(t, []int{}) // fail
+func Zero(t *testing.T, val any, message ...any) error {
+	t.Helper()
+
+	return tryZero(t, false, val, message...)
+}
+
+// ZeroNow tests whether the value is the zero value for its type, and it'll stop the execution if
+// the value is not the zero value.
+//
+//	assert.ZeroNow(t, 0) // success
+//	assert.ZeroNow(t, []int{}) // fail and terminate
+//	// never run
+func ZeroNow(t *testing.T, val any, message ...any) error {
+	t.Helper()
+
+	return tryZero(t, true, val, message...)
+}
+
+// NotZero tests whether the value is the zero value for its type, and it'll set the result to fail
+// if the value is the zero value. Unlike True, an empty but non-nil slice or map is not zero.
+//
+//	assert.NotZero(t, 1) // success
+//	assert.NotZero(t, []int{}) // success
+//	assert.NotZero(t, 0) // fail
+//	assert.NotZero(t, nil) // fail
+func NotZero(t *testing.T, val any, message ...any) error {
+	t.Helper()
+
+	return tryNotZero(t, false, val, message...)
+}
+
+// NotZeroNow tests whether the value is the zero value for its type, and it'll stop the execution
+// if the value is the zero value.
+//
+//	assert.NotZeroNow(t, 1) // success
+//	assert.NotZeroNow(t, 0) // fail and terminate
+//	// never run
+func NotZeroNow(t *testing.T, val any, message ...any) error {
+	t.Helper()
+
+	return tryNotZero(t, true, val, message...)
+}
+
+// Same tests whether the values refer to the same underlying object, and it'll set the result to
+// fail with their types and addresses if they don't. The values must be the pointers, the maps,
+// the slices, the channels, the functions, or the untyped nil, and it'll panic if any of them is
+// not. The values of different types are never the same, and the slices are the same only if they
+// start at the same address and have the same length. The untyped nil, the non-nil slices with zero
+// capacity, and the non-nil pointers to zero-size values are never the same as any value, because
+// they don't refer to a distinct object. The functions are compared by their code pointers, so the
+// closures created by the same function literal are the same even if they capture different
+// variables.
+//
+//	p1 := new(int)
+//	p2 := new(int)
+//	assert.Same(t, p1, p1) // success
+//	assert.Same(t, p1, p2) // fail
+func Same(t *testing.T, actual, expect any, message ...any) error {
+	t.Helper()
+
+	return trySame(t, false, actual, expect, message...)
+}
+
+// SameNow tests whether the values refer to the same underlying object, and it'll stop the
+// execution if they don't. It'll panic if any value is not a pointer, a map, a slice, a channel,
+// a function, or the untyped nil.
+//
+//	p1 := new(int)
+//	p2 := new(int)
+//	assert.SameNow(t, p1, p1) // success
+//	assert.SameNow(t, p1, p2) // fail and terminate
+//	// never run
+func SameNow(t *testing.T, actual, expect any, message ...any) error {
+	t.Helper()
+
+	return trySame(t, true, actual, expect, message...)
+}
+
+// NotSame tests whether the values refer to the same underlying object, and it'll set the result
+// to fail with their type and address if they do. It'll panic if any value is not a pointer, a
+// map, a slice, a channel, a function, or the untyped nil. The functions are compared by their code
+// pointers, so it always fails for the closures created by the same function literal.
+//
+//	p1 := new(int)
+//	p2 := new(int)
+//	assert.NotSame(t, p1, p2) // success
+//	assert.NotSame(t, p1, p1) // fail
+func NotSame(t *testing.T, actual, expect any, message ...any) error {
+	t.Helper()
+
+	return tryNotSame(t, false, actual, expect, message...)
+}
+
+// NotSameNow tests whether the values refer to the same underlying object, and it'll stop the
+// execution if they do. It'll panic if any value is not a pointer, a map, a slice, a channel, a
+// function, or the untyped nil.
+//
+//	p1 := new(int)
+//	p2 := new(int)
+//	assert.NotSameNow(t, p1, p2) // success
+//	assert.NotSameNow(t, p1, p1) // fail and terminate
+//	// never run
+func NotSameNow(t *testing.T, actual, expect any, message ...any) error {
+	t.Helper()
+
+	return tryNotSame(t, true, actual, expect, message...)
+}
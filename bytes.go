@@ -0,0 +1,302 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// bytesDiffRowSize is the number of bytes of each row in the side-by-side hexdumps.
+	bytesDiffRowSize = 8
+	// bytesDiffContextRows is the number of rows to print before and after the row of the first
+	// difference in the side-by-side hexdumps.
+	bytesDiffContextRows = 2
+	// maxBytesDumpLength is the maximum number of bytes to print in the hexdump of the bytes.
+	maxBytesDumpLength = 256
+)
+
+// BytesEqual tests whether the byte slices are the same, and a nil slice equals to an empty slice.
+// It'll set the result to fail with the offset of the first different byte, and the side-by-side
+// hexdumps around it with the different bytes marked if they are not the same.
+//
+//	a := assert.New(t)
+//	a.BytesEqual([]byte{0x01, 0x02}, []byte{0x01, 0x02}) // success
+//	a.BytesEqual([]byte{0x01, 0x02}, []byte{0x01, 0x03}) // fail
+func (a *Assertion) BytesEqual(actual, expected []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesEqual(a.testingT(), false, actual, expected, message...)
+}
+
+// BytesEqualNow tests whether the byte slices are the same, and it will terminate the execution
+// if they are not the same.
+//
+//	a := assert.New(t)
+//	a.BytesEqualNow([]byte{0x01, 0x02}, []byte{0x01, 0x02}) // success
+//	a.BytesEqualNow([]byte{0x01, 0x02}, []byte{0x01, 0x03}) // fail and terminate
+//	// never runs
+func (a *Assertion) BytesEqualNow(actual, expected []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesEqual(a.testingT(), true, actual, expected, message...)
+}
+
+// BytesHasPrefix tests whether the byte slice begins with the prefix, and it set the result to
+// fail with the offset of the first different byte, and the side-by-side hexdumps of the prefix
+// and the beginning of the byte slice if it does not have the prefix.
+//
+//	a := assert.New(t)
+//	a.BytesHasPrefix([]byte{0xca, 0xfe, 0x01}, []byte{0xca, 0xfe}) // success
+//	a.BytesHasPrefix([]byte{0xca, 0xfe, 0x01}, []byte{0xbe, 0xef}) // fail
+func (a *Assertion) BytesHasPrefix(b, prefix []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesHasPrefix(a.testingT(), false, b, prefix, message...)
+}
+
+// BytesHasPrefixNow tests whether the byte slice begins with the prefix, and it will terminate
+// the execution if it does not have the prefix.
+//
+//	a := assert.New(t)
+//	a.BytesHasPrefixNow([]byte{0xca, 0xfe, 0x01}, []byte{0xca, 0xfe}) // success
+//	a.BytesHasPrefixNow([]byte{0xca, 0xfe, 0x01}, []byte{0xbe, 0xef}) // fail and terminate
+//	// never runs
+func (a *Assertion) BytesHasPrefixNow(b, prefix []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesHasPrefix(a.testingT(), true, b, prefix, message...)
+}
+
+// BytesContains tests whether the byte slice contains the sub-slice, and it set the result to fail
+// with the hexdumps of the sub-slice and the byte slice if it does not contain the sub-slice.
+//
+//	a := assert.New(t)
+//	a.BytesContains([]byte{0x01, 0x02, 0x03}, []byte{0x02, 0x03}) // success
+//	a.BytesContains([]byte{0x01, 0x02, 0x03}, []byte{0x03, 0x02}) // fail
+func (a *Assertion) BytesContains(b, sub []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesContains(a.testingT(), false, b, sub, message...)
+}
+
+// BytesContainsNow tests whether the byte slice contains the sub-slice, and it will terminate the
+// execution if it does not contain the sub-slice.
+//
+//	a := assert.New(t)
+//	a.BytesContainsNow([]byte{0x01, 0x02, 0x03}, []byte{0x02, 0x03}) // success
+//	a.BytesContainsNow([]byte{0x01, 0x02, 0x03}, []byte{0x03, 0x02}) // fail and terminate
+//	// never runs
+func (a *Assertion) BytesContainsNow(b, sub []byte, message ...any) error {
+	a.Helper()
+
+	return tryBytesContains(a.testingT(), true, b, sub, message...)
+}
+
+// BytesLen tests whether the length of the byte slice is n, and it set the result to fail if the
+// length is not n.
+//
+//	a := assert.New(t)
+//	a.BytesLen([]byte{0x01, 0x02}, 2) // success
+//	a.BytesLen([]byte{0x01, 0x02}, 3) // fail
+func (a *Assertion) BytesLen(b []byte, n int, message ...any) error {
+	a.Helper()
+
+	return tryBytesLen(a.testingT(), false, b, n, message...)
+}
+
+// BytesLenNow tests whether the length of the byte slice is n, and it will terminate the
+// execution if the length is not n.
+//
+//	a := assert.New(t)
+//	a.BytesLenNow([]byte{0x01, 0x02}, 2) // success
+//	a.BytesLenNow([]byte{0x01, 0x02}, 3) // fail and terminate
+//	// never runs
+func (a *Assertion) BytesLenNow(b []byte, n int, message ...any) error {
+	a.Helper()
+
+	return tryBytesLen(a.testingT(), true, b, n, message...)
+}
+
+// tryBytesEqual tries to test whether the byte slices are the same, and it'll fail if they are not
+// the same.
+func tryBytesEqual(t testingT, failedNow bool, actual, expected []byte, message ...any) error {
+	t.Helper()
+
+	offset := findBytesMismatch(actual, expected)
+
+	defaultMessage := ""
+	if offset >= 0 {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageBytesEqual,
+			offset, len(expected), len(actual), formatBytesDiff(expected, actual, offset),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return offset < 0 },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryBytesHasPrefix tries to test whether the byte slice begins with the prefix, and it'll fail if
+// it does not have the prefix.
+func tryBytesHasPrefix(t testingT, failedNow bool, b, prefix []byte, message ...any) error {
+	t.Helper()
+
+	head := b[:minInt(len(b), len(prefix))]
+	offset := findBytesMismatch(head, prefix)
+
+	defaultMessage := ""
+	if offset >= 0 {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageBytesHasPrefix, offset, formatBytesDiff(prefix, head, offset),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return offset < 0 },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryBytesContains tries to test whether the byte slice contains the sub-slice, and it'll fail if
+// it does not contain the sub-slice.
+func tryBytesContains(t testingT, failedNow bool, b, sub []byte, message ...any) error {
+	t.Helper()
+
+	isContains := bytes.Contains(b, sub)
+
+	defaultMessage := ""
+	if !isContains {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageBytesContains, formatBytesDump(sub), formatBytesDump(b),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return isContains },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryBytesLen tries to test whether the length of the byte slice is n, and it'll fail if the
+// length is not n.
+func tryBytesLen(t testingT, failedNow bool, b []byte, n int, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return len(b) == n },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageBytesLen, n, len(b)),
+		message...,
+	)
+}
+
+// findBytesMismatch returns the offset of the first different byte of the byte slices, and the
+// length of the shorter one if it is the prefix of the other. It returns -1 if they are the same.
+func findBytesMismatch(actual, expected []byte) int {
+	n := minInt(len(actual), len(expected))
+	for i := 0; i < n; i++ {
+		if actual[i] != expected[i] {
+			return i
+		}
+	}
+
+	if len(actual) != len(expected) {
+		return n
+	}
+
+	return -1
+}
+
+// formatBytesDiff formats the rows around the offset of the byte slices as the side-by-side
+// hexdumps, and marks the different bytes, including the bytes that only exist in one side, with
+// the carets under the rows.
+func formatBytesDiff(expected, actual []byte, offset int) string {
+	// the width of a side: the hex values with the separators, and the printable characters between
+	// the bars.
+	width := bytesDiffRowSize*3 + bytesDiffRowSize + 2
+
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("%-8s  %-*s  %s", "offset", width, "expected", "actual"))
+
+	row := offset / bytesDiffRowSize
+	start := maxInt(row-bytesDiffContextRows, 0) * bytesDiffRowSize
+	end := minInt(
+		(row+bytesDiffContextRows+1)*bytesDiffRowSize,
+		maxInt(len(expected), len(actual)),
+	)
+
+	for i := start; i < end; i += bytesDiffRowSize {
+		expectedRow, expectedMarks := formatBytesDiffRow(expected, actual, i)
+		actualRow, actualMarks := formatBytesDiffRow(actual, expected, i)
+
+		builder.WriteString(fmt.Sprintf("\n%08x  %s  %s", i, expectedRow, actualRow))
+		if strings.TrimSpace(expectedMarks+actualMarks) != "" {
+			marks := fmt.Sprintf("%10s%-*s  %s", "", width, expectedMarks, actualMarks)
+			builder.WriteString("\n" + strings.TrimRight(marks, " "))
+		}
+	}
+
+	return builder.String()
+}
+
+// formatBytesDiffRow formats the row of the data from the start offset as the hex values and the
+// printable characters, and returns the row and the carets under the bytes that are different from
+// the other data.
+func formatBytesDiffRow(data, other []byte, start int) (string, string) {
+	row := strings.Builder{}
+	marks := strings.Builder{}
+	chars := strings.Builder{}
+
+	for i := start; i < start+bytesDiffRowSize; i++ {
+		if i < len(data) {
+			row.WriteString(fmt.Sprintf("%02x ", data[i]))
+			if data[i] >= 0x20 && data[i] < 0x7f {
+				chars.WriteByte(data[i])
+			} else {
+				chars.WriteByte('.')
+			}
+		} else {
+			row.WriteString("   ")
+			chars.WriteByte(' ')
+		}
+
+		switch {
+		case i >= len(data) && i >= len(other):
+			marks.WriteString("   ")
+		case i >= len(data) || i >= len(other) || data[i] != other[i]:
+			marks.WriteString("^^ ")
+		default:
+			marks.WriteString("   ")
+		}
+	}
+
+	row.WriteString("|" + chars.String() + "|")
+
+	return row.String(), marks.String()
+}
+
+// formatBytesDump formats the bytes as a hexdump, and the bytes after the maximum length will be
+// truncated.
+func formatBytesDump(data []byte) string {
+	if len(data) <= maxBytesDumpLength {
+		return formatHexdump(data, 0)
+	}
+
+	return fmt.Sprintf(
+		"%s\n... (%d bytes truncated)",
+		formatHexdump(data[:maxBytesDumpLength], 0), len(data)-maxBytesDumpLength,
+	)
+}
@@ -0,0 +1,183 @@
+package assert
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestBytesEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testBytesEqual(a, mockA, nil, nil, true)
+	testBytesEqual(a, mockA, nil, []byte{}, true)
+	testBytesEqual(a, mockA, []byte{0x01, 0x02}, []byte{0x01, 0x02}, true)
+	testBytesEqual(a, mockA, []byte{0x01, 0x02}, []byte{0x01, 0x03}, false)
+	testBytesEqual(a, mockA, []byte{0x01, 0x02}, []byte{0x01}, false)
+	testBytesEqual(a, mockA, nil, []byte{0x00}, false)
+}
+
+func testBytesEqual(a, mockA *Assertion, actual, expected []byte, isEqual bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "BytesEqual", func() error {
+		return BytesEqual(mockA.T, actual, expected)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion.BytesEqual", func() error {
+		return mockA.BytesEqual(actual, expected)
+	}, isEqual)
+	testAssertionNowFunction(a, "BytesEqualNow", func() {
+		BytesEqualNow(mockA.T, actual, expected)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion.BytesEqualNow", func() {
+		mockA.BytesEqualNow(actual, expected)
+	}, !isEqual)
+}
+
+func TestBytesHasPrefix(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	b := []byte{0xca, 0xfe, 0x01}
+
+	testBytesHasPrefix(a, mockA, b, nil, true)
+	testBytesHasPrefix(a, mockA, b, []byte{0xca, 0xfe}, true)
+	testBytesHasPrefix(a, mockA, b, b, true)
+	testBytesHasPrefix(a, mockA, b, []byte{0xbe, 0xef}, false)
+	testBytesHasPrefix(a, mockA, b, []byte{0xca, 0xfe, 0x01, 0x02}, false)
+	testBytesHasPrefix(a, mockA, nil, []byte{0xca}, false)
+}
+
+func testBytesHasPrefix(a, mockA *Assertion, b, prefix []byte, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "BytesHasPrefix", func() error {
+		return BytesHasPrefix(mockA.T, b, prefix)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.BytesHasPrefix", func() error {
+		return mockA.BytesHasPrefix(b, prefix)
+	}, isOk)
+	testAssertionNowFunction(a, "BytesHasPrefixNow", func() {
+		BytesHasPrefixNow(mockA.T, b, prefix)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.BytesHasPrefixNow", func() {
+		mockA.BytesHasPrefixNow(b, prefix)
+	}, !isOk)
+}
+
+func TestBytesContains(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	b := []byte{0x01, 0x02, 0x03}
+
+	testBytesContains(a, mockA, b, nil, true)
+	testBytesContains(a, mockA, b, []byte{0x02, 0x03}, true)
+	testBytesContains(a, mockA, b, []byte{0x03, 0x02}, false)
+	testBytesContains(a, mockA, nil, []byte{0x01}, false)
+}
+
+func testBytesContains(a, mockA *Assertion, b, sub []byte, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "BytesContains", func() error {
+		return BytesContains(mockA.T, b, sub)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.BytesContains", func() error {
+		return mockA.BytesContains(b, sub)
+	}, isOk)
+	testAssertionNowFunction(a, "BytesContainsNow", func() {
+		BytesContainsNow(mockA.T, b, sub)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.BytesContainsNow", func() {
+		mockA.BytesContainsNow(b, sub)
+	}, !isOk)
+}
+
+func TestBytesLen(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testBytesLen(a, mockA, nil, 0, true)
+	testBytesLen(a, mockA, []byte{0x01, 0x02}, 2, true)
+	testBytesLen(a, mockA, []byte{0x01, 0x02}, 3, false)
+}
+
+func testBytesLen(a, mockA *Assertion, b []byte, n int, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "BytesLen", func() error {
+		return BytesLen(mockA.T, b, n)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.BytesLen", func() error {
+		return mockA.BytesLen(b, n)
+	}, isOk)
+	testAssertionNowFunction(a, "BytesLenNow", func() {
+		BytesLenNow(mockA.T, b, n)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.BytesLenNow", func() {
+		mockA.BytesLenNow(b, n)
+	}, !isOk)
+}
+
+func TestBytesMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.BytesEqual(
+		[]byte("Hello world, this is a long message"),
+		[]byte("Hello world, that is a long message!"),
+	)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect bytes equal, first difference at offset 15 (expected 36 bytes, got 35 bytes):
+
+offset    expected                            actual
+00000000  48 65 6c 6c 6f 20 77 6f |Hello wo|  48 65 6c 6c 6f 20 77 6f |Hello wo|
+00000008  72 6c 64 2c 20 74 68 61 |rld, tha|  72 6c 64 2c 20 74 68 69 |rld, thi|
+                               ^^                                  ^^
+00000010  74 20 69 73 20 61 20 6c |t is a l|  73 20 69 73 20 61 20 6c |s is a l|
+          ^^                                  ^^
+00000018  6f 6e 67 20 6d 65 73 73 |ong mess|  6f 6e 67 20 6d 65 73 73 |ong mess|`)
+
+	err = mockA.BytesHasPrefix([]byte{0xca}, []byte{0xca, 0xfe})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect bytes have prefix, first difference at offset 1:
+
+offset    expected                            actual
+00000000  ca fe                   |..      |  ca                      |.       |
+             ^^                                  ^^`)
+
+	err = mockA.BytesContains([]byte("abc"), []byte{0xca, 0xfe})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect bytes contain:
+00000000  ca fe                                             |..|
+got:
+00000000  61 62 63                                          |abc|`)
+
+	err = mockA.BytesLen([]byte{0x01}, 2)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect 2 bytes, got 1")
+}
+
+func TestFormatBytesDiff(t *testing.T) {
+	a := New(t)
+
+	expected := bytes.Repeat([]byte{0x00}, 64)
+	actual := bytes.Repeat([]byte{0x00}, 64)
+	actual[40] = 0xff
+
+	// only prints the rows around the first difference
+	lines := strings.Split(formatBytesDiff(expected, actual, 40), "\n")
+	a.EqualNow(len(lines), 7)
+	a.TrueNow(strings.HasPrefix(lines[1], "00000018  "))
+	a.TrueNow(strings.HasPrefix(lines[6], "00000038  "))
+}
+
+func TestFormatBytesDump(t *testing.T) {
+	a := New(t)
+
+	dump := formatBytesDump(make([]byte, maxBytesDumpLength+10))
+	a.TrueNow(strings.HasSuffix(dump, "\n... (10 bytes truncated)"))
+	a.EqualNow(strings.Count(dump, "\n"), maxBytesDumpLength/16)
+}
@@ -0,0 +1,467 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Receives tests whether a value can be received from the channel within the duration, and
+// returns the received value. It'll set the result to fail if no value is received in time, or the
+// channel is closed. It'll panic if the channel is not a receivable channel.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 1)
+//	ch <- 1
+//	v, _ := a.Receives(ch, time.Second) // success, v is 1
+//	a.Receives(ch, 10*time.Millisecond) // fail
+func (a *Assertion) Receives(ch any, d time.Duration, message ...any) (any, error) {
+	a.Helper()
+
+	return tryReceives(a.testingT(), false, ch, d, message...)
+}
+
+// ReceivesNow tests whether a value can be received from the channel within the duration, and
+// returns the received value. It'll terminate the execution if no value is received in time, or
+// the channel is closed.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 1)
+//	ch <- 1
+//	v, _ := a.ReceivesNow(ch, time.Second) // success, v is 1
+//	a.ReceivesNow(ch, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func (a *Assertion) ReceivesNow(ch any, d time.Duration, message ...any) (any, error) {
+	a.Helper()
+
+	return tryReceives(a.testingT(), true, ch, d, message...)
+}
+
+// ReceivesValue tests whether a value can be received from the channel within the duration, and
+// the received value equals to the expected value. It'll set the result to fail if no value is
+// received in time, the channel is closed, or the received value is not the expected value.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	ch <- 2
+//	a.ReceivesValue(ch, 1, time.Second) // success
+//	a.ReceivesValue(ch, 1, time.Second) // fail
+func (a *Assertion) ReceivesValue(ch, expected any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryReceivesValue(a.testingT(), false, ch, expected, d, message...)
+}
+
+// ReceivesValueNow tests whether a value can be received from the channel within the duration, and
+// the received value equals to the expected value. It'll terminate the execution if no value is
+// received in time, the channel is closed, or the received value is not the expected value.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	ch <- 2
+//	a.ReceivesValueNow(ch, 1, time.Second) // success
+//	a.ReceivesValueNow(ch, 1, time.Second) // fail and terminate
+//	// never runs
+func (a *Assertion) ReceivesValueNow(ch, expected any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryReceivesValue(a.testingT(), true, ch, expected, d, message...)
+}
+
+// NotReceives tests whether no value is received from the channel within the duration, and it'll
+// set the result to fail if a value is received. The received value will be consumed, and a closed
+// channel is treated as no value received.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 1)
+//	a.NotReceives(ch, 10*time.Millisecond) // success
+//	ch <- 1
+//	a.NotReceives(ch, 10*time.Millisecond) // fail
+func (a *Assertion) NotReceives(ch any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryNotReceives(a.testingT(), false, ch, d, message...)
+}
+
+// NotReceivesNow tests whether no value is received from the channel within the duration, and
+// it'll terminate the execution if a value is received.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 1)
+//	a.NotReceivesNow(ch, 10*time.Millisecond) // success
+//	ch <- 1
+//	a.NotReceivesNow(ch, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func (a *Assertion) NotReceivesNow(ch any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryNotReceives(a.testingT(), true, ch, d, message...)
+}
+
+// Closed tests whether the channel is closed and drained, and it'll set the result to fail if the
+// channel is not closed. A closed channel that still has buffered values is treated as not closed,
+// and the buffered values will not be consumed.
+//
+// To check a channel without buffered values, it tries to receive from the channel without
+// blocking. It may receive the value of a pending sender, for example, a sender that is blocked on
+// an unbuffered channel, and the received value will be dropped and cannot be pushed back to the
+// channel.
+//
+//	a := assert.New(t)
+//	ch := make(chan int)
+//	close(ch)
+//	a.Closed(ch) // success
+//	a.Closed(make(chan int)) // fail
+func (a *Assertion) Closed(ch any, message ...any) error {
+	a.Helper()
+
+	return tryClosed(a.testingT(), false, ch, message...)
+}
+
+// ClosedNow tests whether the channel is closed and drained, and it'll terminate the execution if
+// the channel is not closed.
+//
+//	a := assert.New(t)
+//	ch := make(chan int)
+//	close(ch)
+//	a.ClosedNow(ch) // success
+//	a.ClosedNow(make(chan int)) // fail and terminate
+//	// never runs
+func (a *Assertion) ClosedNow(ch any, message ...any) error {
+	a.Helper()
+
+	return tryClosed(a.testingT(), true, ch, message...)
+}
+
+// NotClosed tests whether the channel is not closed, and it'll set the result to fail if the
+// channel is closed and drained.
+//
+//	a := assert.New(t)
+//	a.NotClosed(make(chan int)) // success
+//	ch := make(chan int)
+//	close(ch)
+//	a.NotClosed(ch) // fail
+func (a *Assertion) NotClosed(ch any, message ...any) error {
+	a.Helper()
+
+	return tryNotClosed(a.testingT(), false, ch, message...)
+}
+
+// NotClosedNow tests whether the channel is not closed, and it'll terminate the execution if the
+// channel is closed and drained.
+//
+//	a := assert.New(t)
+//	a.NotClosedNow(make(chan int)) // success
+//	ch := make(chan int)
+//	close(ch)
+//	a.NotClosedNow(ch) // fail and terminate
+//	// never runs
+func (a *Assertion) NotClosedNow(ch any, message ...any) error {
+	a.Helper()
+
+	return tryNotClosed(a.testingT(), true, ch, message...)
+}
+
+// ChannelLen tests whether the number of the buffered values in the channel is the expected
+// length, and it'll set the result to fail if the length is not the expected length.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	a.ChannelLen(ch, 1) // success
+//	a.ChannelLen(ch, 2) // fail
+func (a *Assertion) ChannelLen(ch any, length int, message ...any) error {
+	a.Helper()
+
+	return tryChannelLen(a.testingT(), false, ch, length, message...)
+}
+
+// ChannelLenNow tests whether the number of the buffered values in the channel is the expected
+// length, and it'll terminate the execution if the length is not the expected length.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 2)
+//	ch <- 1
+//	a.ChannelLenNow(ch, 1) // success
+//	a.ChannelLenNow(ch, 2) // fail and terminate
+//	// never runs
+func (a *Assertion) ChannelLenNow(ch any, length int, message ...any) error {
+	a.Helper()
+
+	return tryChannelLen(a.testingT(), true, ch, length, message...)
+}
+
+// ChannelYields drains the channel until it is closed, and tests whether the received values are
+// the expected values in order. It'll set the result to fail if the channel is not closed within
+// the duration, or the received values are not the expected values.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//	a.ChannelYields(ch, []int{1, 2}, time.Second) // success
+//	a.ChannelYields(make(chan int), []int{}, 10*time.Millisecond) // fail
+func (a *Assertion) ChannelYields(ch, expected any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryChannelYields(a.testingT(), false, ch, expected, d, message...)
+}
+
+// ChannelYieldsNow drains the channel until it is closed, and tests whether the received values
+// are the expected values in order. It'll terminate the execution if the channel is not closed
+// within the duration, or the received values are not the expected values.
+//
+//	a := assert.New(t)
+//	ch := make(chan int, 3)
+//	ch <- 1
+//	ch <- 2
+//	close(ch)
+//	a.ChannelYieldsNow(ch, []int{1, 2}, time.Second) // success
+//	a.ChannelYieldsNow(make(chan int), []int{}, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func (a *Assertion) ChannelYieldsNow(ch, expected any, d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryChannelYields(a.testingT(), true, ch, expected, d, message...)
+}
+
+// tryReceives tries to receive a value from the channel, and it'll fail if no value is received
+// in time or the channel is closed.
+func tryReceives(
+	t testingT,
+	failedNow bool,
+	ch any,
+	d time.Duration,
+	message ...any,
+) (any, error) {
+	t.Helper()
+
+	value, isReceived, isClosed := receiveWithTimeout(getReceivableChannel(ch), d)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageReceives, d)
+	if isClosed {
+		defaultMessage = defaultErrMessageChannelClosed
+	}
+
+	return value, test(
+		t,
+		func() bool { return isReceived },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryReceivesValue tries to receive a value from the channel, and it'll fail if no value is
+// received in time, the channel is closed, or the received value is not the expected value.
+func tryReceivesValue(
+	t testingT,
+	failedNow bool,
+	ch, expected any,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	value, isReceived, isClosed := receiveWithTimeout(getReceivableChannel(ch), d)
+
+	defaultMessage := fmt.Sprintf(
+		defaultErrMessageReceivesValue, formatValue(expected), formatValue(value),
+	)
+	if isClosed {
+		defaultMessage = defaultErrMessageChannelClosed
+	} else if !isReceived {
+		defaultMessage = fmt.Sprintf(defaultErrMessageReceives, d)
+	}
+
+	return test(
+		t,
+		func() bool { return isReceived && isEqual(value, expected) },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryNotReceives tries to receive a value from the channel, and it'll fail if a value is received
+// in time.
+func tryNotReceives(
+	t testingT,
+	failedNow bool,
+	ch any,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	value, isReceived, _ := receiveWithTimeout(getReceivableChannel(ch), d)
+
+	return test(
+		t,
+		func() bool { return !isReceived },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageNotReceives, d, formatValue(value)),
+		message...,
+	)
+}
+
+// tryClosed tries to test whether the channel is closed, and it'll fail if the channel is not
+// closed.
+func tryClosed(t testingT, failedNow bool, ch any, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isChannelClosed(getReceivableChannel(ch)) },
+		failedNow,
+		defaultErrMessageClosed,
+		message...,
+	)
+}
+
+// tryNotClosed tries to test whether the channel is not closed, and it'll fail if the channel is
+// closed.
+func tryNotClosed(t testingT, failedNow bool, ch any, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return !isChannelClosed(getReceivableChannel(ch)) },
+		failedNow,
+		defaultErrMessageNotClosed,
+		message...,
+	)
+}
+
+// tryChannelLen tries to test the number of the buffered values in the channel, and it'll fail if
+// the length is not the expected length.
+func tryChannelLen(t testingT, failedNow bool, ch any, length int, message ...any) error {
+	t.Helper()
+
+	cv := reflect.ValueOf(ch)
+	if cv.Kind() != reflect.Chan {
+		panic(ErrNotChannel)
+	}
+
+	return test(
+		t,
+		func() bool { return cv.Len() == length },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageChannelLen, length, cv.Len()),
+		message...,
+	)
+}
+
+// tryChannelYields tries to drain the channel and compare the received values with the expected
+// values, and it'll fail if the channel is not closed in time or the values are not the same.
+func tryChannelYields(
+	t testingT,
+	failedNow bool,
+	ch, expected any,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	ev := reflect.ValueOf(expected)
+	if ev.Kind() != reflect.Slice && ev.Kind() != reflect.Array {
+		panic(ErrNotArray)
+	}
+
+	values, isClosed := drainChannel(getReceivableChannel(ch), d)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageChannelYields, expected, values)
+	if !isClosed {
+		defaultMessage = fmt.Sprintf(defaultErrMessageChannelNotClosed, d, values)
+	}
+
+	return test(
+		t,
+		func() bool {
+			if !isClosed || len(values) != ev.Len() {
+				return false
+			}
+			for i, v := range values {
+				if !isEqual(v, ev.Index(i).Interface()) {
+					return false
+				}
+			}
+			return true
+		},
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// getReceivableChannel returns the reflect value of the channel, and it'll panic if the value is
+// not a channel that can receive values.
+func getReceivableChannel(ch any) reflect.Value {
+	cv := reflect.ValueOf(ch)
+	if cv.Kind() != reflect.Chan || cv.Type().ChanDir()&reflect.RecvDir == 0 {
+		panic(ErrNotChannel)
+	}
+
+	return cv
+}
+
+// receiveWithTimeout tries to receive a value from the channel within the duration. It returns
+// the received value, whether a value is received, and whether the channel is closed.
+func receiveWithTimeout(cv reflect.Value, d time.Duration) (any, bool, bool) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	chosen, value, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: cv},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	})
+	if chosen != 0 {
+		return nil, false, false
+	} else if !ok {
+		return nil, false, true
+	}
+
+	return value.Interface(), true, false
+}
+
+// drainChannel receives all the values from the channel until it is closed or the duration
+// elapsed. It returns the received values, and whether the channel is closed.
+func drainChannel(cv reflect.Value, d time.Duration) ([]any, bool) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: cv},
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(timer.C)},
+	}
+	values := make([]any, 0)
+
+	for {
+		chosen, value, ok := reflect.Select(cases)
+		if chosen != 0 {
+			return values, false
+		} else if !ok {
+			return values, true
+		}
+		values = append(values, value.Interface())
+	}
+}
+
+// isChannelClosed checks whether the channel is closed and has no buffered value. It tries to
+// receive from the channel without blocking only if the channel has no buffered value, so it may
+// receive and drop the value of a pending sender.
+func isChannelClosed(cv reflect.Value) bool {
+	if cv.IsNil() || cv.Len() > 0 {
+		return false
+	}
+
+	chosen, _, ok := reflect.Select([]reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: cv},
+		{Dir: reflect.SelectDefault},
+	})
+
+	return chosen == 0 && !ok
+}
@@ -0,0 +1,355 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReceives(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	d := 10 * time.Millisecond
+
+	testReceives(a, mockA, func() any {
+		ch := make(chan int, 1)
+		ch <- 1
+		return ch
+	}, d, 1, true)
+	testReceives(a, mockA, func() any {
+		ch := make(chan string)
+		go func() { ch <- "test" }()
+		return ch
+	}, time.Second, "test", true)
+	testReceives(a, mockA, func() any {
+		ch := make(chan int, 1)
+		ch <- 1
+		return (<-chan int)(ch)
+	}, d, 1, true)
+	testReceives(a, mockA, func() any {
+		return make(chan int)
+	}, d, nil, false)
+	testReceives(a, mockA, func() any {
+		ch := make(chan int)
+		close(ch)
+		return ch
+	}, d, nil, false)
+	testReceives(a, mockA, func() any {
+		var ch chan int
+		return ch
+	}, d, nil, false)
+
+	a.PanicNow(func() {
+		mockA.Receives(1, d)
+	})
+	a.PanicNow(func() {
+		mockA.Receives(make(chan<- int), d)
+	})
+}
+
+func testReceives(
+	a, mockA *Assertion,
+	newChannel func() any,
+	d time.Duration,
+	expected any,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "Receives", func() error {
+		v, err := Receives(mockA.T, newChannel(), d)
+		a.EqualNow(v, expected)
+		return err
+	}, isOk)
+	testAssertionFunction(a, "Assertion.Receives", func() error {
+		v, err := mockA.Receives(newChannel(), d)
+		a.EqualNow(v, expected)
+		return err
+	}, isOk)
+	testAssertionNowFunction(a, "ReceivesNow", func() {
+		ReceivesNow(mockA.T, newChannel(), d)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ReceivesNow", func() {
+		mockA.ReceivesNow(newChannel(), d)
+	}, !isOk)
+}
+
+func TestReceivesValue(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	d := 10 * time.Millisecond
+
+	testReceivesValue(a, mockA, func() any {
+		ch := make(chan int, 1)
+		ch <- 1
+		return ch
+	}, 1, d, true)
+	testReceivesValue(a, mockA, func() any {
+		ch := make(chan int, 1)
+		ch <- 2
+		return ch
+	}, 1, d, false)
+	testReceivesValue(a, mockA, func() any {
+		ch := make(chan any, 1)
+		ch <- "test"
+		return ch
+	}, "test", d, true)
+	testReceivesValue(a, mockA, func() any {
+		return make(chan int)
+	}, 0, d, false)
+	testReceivesValue(a, mockA, func() any {
+		ch := make(chan int)
+		close(ch)
+		return ch
+	}, 0, d, false)
+}
+
+func testReceivesValue(
+	a, mockA *Assertion,
+	newChannel func() any,
+	expected any,
+	d time.Duration,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "ReceivesValue", func() error {
+		return ReceivesValue(mockA.T, newChannel(), expected, d)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ReceivesValue", func() error {
+		return mockA.ReceivesValue(newChannel(), expected, d)
+	}, isOk)
+	testAssertionNowFunction(a, "ReceivesValueNow", func() {
+		ReceivesValueNow(mockA.T, newChannel(), expected, d)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ReceivesValueNow", func() {
+		mockA.ReceivesValueNow(newChannel(), expected, d)
+	}, !isOk)
+}
+
+func TestNotReceives(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	d := 10 * time.Millisecond
+
+	testNotReceives(a, mockA, func() any {
+		return make(chan int)
+	}, d, true)
+	testNotReceives(a, mockA, func() any {
+		ch := make(chan int)
+		close(ch)
+		return ch
+	}, d, true)
+	testNotReceives(a, mockA, func() any {
+		ch := make(chan int, 1)
+		ch <- 1
+		return ch
+	}, d, false)
+}
+
+func testNotReceives(a, mockA *Assertion, newChannel func() any, d time.Duration, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "NotReceives", func() error {
+		return NotReceives(mockA.T, newChannel(), d)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.NotReceives", func() error {
+		return mockA.NotReceives(newChannel(), d)
+	}, isOk)
+	testAssertionNowFunction(a, "NotReceivesNow", func() {
+		NotReceivesNow(mockA.T, newChannel(), d)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.NotReceivesNow", func() {
+		mockA.NotReceivesNow(newChannel(), d)
+	}, !isOk)
+}
+
+func TestClosedAndNotClosed(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	closedCh := make(chan int)
+	close(closedCh)
+	doneCh := make(chan struct{})
+	close(doneCh)
+	bufferedCh := make(chan int, 1)
+	bufferedCh <- 1
+	closedBufferedCh := make(chan int, 1)
+	closedBufferedCh <- 1
+	close(closedBufferedCh)
+	drainedCh := make(chan int, 1)
+	close(drainedCh)
+	var nilCh chan int
+
+	testClosedAndNotClosed(a, mockA, closedCh, true)
+	testClosedAndNotClosed(a, mockA, (<-chan int)(closedCh), true)
+	testClosedAndNotClosed(a, mockA, doneCh, true)
+	testClosedAndNotClosed(a, mockA, drainedCh, true)
+	testClosedAndNotClosed(a, mockA, make(chan int), false)
+	testClosedAndNotClosed(a, mockA, make(chan int, 1), false)
+	testClosedAndNotClosed(a, mockA, bufferedCh, false)
+	testClosedAndNotClosed(a, mockA, closedBufferedCh, false)
+	testClosedAndNotClosed(a, mockA, nilCh, false)
+
+	a.EqualNow(len(bufferedCh), 1)
+	a.EqualNow(len(closedBufferedCh), 1)
+
+	a.PanicNow(func() {
+		mockA.Closed([]int{})
+	})
+}
+
+func TestClosedWithBlockedSender(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	ch := make(chan int)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ch <- 1
+	}()
+
+	// the value of the blocked sender is received and dropped by the check.
+	isSent := false
+	for deadline := time.Now().Add(time.Second); !isSent && time.Now().Before(deadline); {
+		a.NilNow(mockA.NotClosed(ch))
+		select {
+		case <-done:
+			isSent = true
+		case <-time.After(time.Millisecond):
+		}
+	}
+	a.TrueNow(isSent)
+	a.NotReceivesNow(ch, 10*time.Millisecond)
+}
+
+func testClosedAndNotClosed(a, mockA *Assertion, ch any, isClosed bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "Closed", func() error {
+		return Closed(mockA.T, ch)
+	}, isClosed)
+	testAssertionFunction(a, "Assertion.Closed", func() error {
+		return mockA.Closed(ch)
+	}, isClosed)
+	testAssertionNowFunction(a, "ClosedNow", func() {
+		ClosedNow(mockA.T, ch)
+	}, !isClosed)
+	testAssertionNowFunction(a, "Assertion.ClosedNow", func() {
+		mockA.ClosedNow(ch)
+	}, !isClosed)
+
+	testAssertionFunction(a, "NotClosed", func() error {
+		return NotClosed(mockA.T, ch)
+	}, !isClosed)
+	testAssertionFunction(a, "Assertion.NotClosed", func() error {
+		return mockA.NotClosed(ch)
+	}, !isClosed)
+	testAssertionNowFunction(a, "NotClosedNow", func() {
+		NotClosedNow(mockA.T, ch)
+	}, isClosed)
+	testAssertionNowFunction(a, "Assertion.NotClosedNow", func() {
+		mockA.NotClosedNow(ch)
+	}, isClosed)
+}
+
+func TestChannelLen(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	ch := make(chan int, 2)
+	ch <- 1
+
+	testChannelLen(a, mockA, ch, 1, true)
+	testChannelLen(a, mockA, ch, 2, false)
+	testChannelLen(a, mockA, make(chan int), 0, true)
+	testChannelLen(a, mockA, make(chan<- int, 1), 0, true)
+
+	a.PanicNow(func() {
+		mockA.ChannelLen([]int{1}, 1)
+	})
+}
+
+func testChannelLen(a, mockA *Assertion, ch any, length int, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "ChannelLen", func() error {
+		return ChannelLen(mockA.T, ch, length)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ChannelLen", func() error {
+		return mockA.ChannelLen(ch, length)
+	}, isOk)
+	testAssertionNowFunction(a, "ChannelLenNow", func() {
+		ChannelLenNow(mockA.T, ch, length)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ChannelLenNow", func() {
+		mockA.ChannelLenNow(ch, length)
+	}, !isOk)
+}
+
+func TestChannelYields(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	d := 10 * time.Millisecond
+	newChannel := func(values []int, isClosed bool) func() any {
+		return func() any {
+			ch := make(chan int, len(values))
+			for _, v := range values {
+				ch <- v
+			}
+			if isClosed {
+				close(ch)
+			}
+			return ch
+		}
+	}
+
+	testChannelYields(a, mockA, newChannel([]int{1, 2}, true), []int{1, 2}, d, true)
+	testChannelYields(a, mockA, newChannel([]int{}, true), []int{}, d, true)
+	testChannelYields(a, mockA, newChannel([]int{1, 2}, true), [2]int{1, 2}, d, true)
+	testChannelYields(a, mockA, newChannel([]int{1, 2}, true), []int{2, 1}, d, false)
+	testChannelYields(a, mockA, newChannel([]int{1, 2}, true), []int{1}, d, false)
+	testChannelYields(a, mockA, newChannel([]int{1}, true), []int{1, 2}, d, false)
+	testChannelYields(a, mockA, newChannel([]int{1, 2}, false), []int{1, 2}, d, false)
+	testChannelYields(a, mockA, func() any {
+		ch := make(chan int)
+		go func() {
+			for i := 0; i < 3; i++ {
+				ch <- i
+			}
+			close(ch)
+		}()
+		return ch
+	}, []int{0, 1, 2}, time.Second, true)
+
+	a.PanicNow(func() {
+		mockA.ChannelYields(make(chan int), 1, d)
+	})
+}
+
+func testChannelYields(
+	a, mockA *Assertion,
+	newChannel func() any,
+	expected any,
+	d time.Duration,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "ChannelYields", func() error {
+		return ChannelYields(mockA.T, newChannel(), expected, d)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ChannelYields", func() error {
+		return mockA.ChannelYields(newChannel(), expected, d)
+	}, isOk)
+	testAssertionNowFunction(a, "ChannelYieldsNow", func() {
+		ChannelYieldsNow(mockA.T, newChannel(), expected, d)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ChannelYieldsNow", func() {
+		mockA.ChannelYieldsNow(newChannel(), expected, d)
+	}, !isOk)
+}
@@ -3,7 +3,7 @@ package assert
 import (
 	"fmt"
 	"reflect"
-	"testing"
+	"strings"
 )
 
 // DeepEqual tests the deep equality between actual and expect parameters. It'll set the result to
@@ -17,7 +17,7 @@ import (
 func (a *Assertion) DeepEqual(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryDeepEqual(a.T, false, actual, expect, message...)
+	return tryDeepEqual(a.testingT(), false, actual, expect, message...)
 }
 
 // DeepEqualNow tests the deep equality between actual and expect parameters, and it'll stop the
@@ -31,7 +31,7 @@ func (a *Assertion) DeepEqual(actual, expect any, message ...any) error {
 func (a *Assertion) DeepEqualNow(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryDeepEqual(a.T, true, actual, expect, message...)
+	return tryDeepEqual(a.testingT(), true, actual, expect, message...)
 }
 
 // NotDeepEqual tests the deep inequality between actual and expected parameters. It'll set the
@@ -45,7 +45,7 @@ func (a *Assertion) DeepEqualNow(actual, expect any, message ...any) error {
 func (a *Assertion) NotDeepEqual(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotDeepEqual(a.T, false, actual, expect, message...)
+	return tryNotDeepEqual(a.testingT(), false, actual, expect, message...)
 }
 
 // NotDeepEqualNow tests the deep inequality between actual and expected parameters, and it'll stop
@@ -59,33 +59,33 @@ func (a *Assertion) NotDeepEqual(actual, expect any, message ...any) error {
 func (a *Assertion) NotDeepEqualNow(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotDeepEqual(a.T, true, actual, expect, message...)
+	return tryNotDeepEqual(a.testingT(), true, actual, expect, message...)
 }
 
 // tryDeepEqual try to testing the deeply equality between actual and expect values, and it'll
 // fail if the values are not deeply equal.
-func tryDeepEqual(t *testing.T, failedNow bool, actual, expect any, message ...any) error {
+func tryDeepEqual(t testingT, failedNow bool, actual, expect any, message ...any) error {
 	t.Helper()
 
 	return test(
 		t,
 		func() bool { return reflect.DeepEqual(actual, expect) },
 		failedNow,
-		fmt.Sprintf(defaultErrMessageEqual, actual, expect),
+		getEqualMessage(actual, expect),
 		message...,
 	)
 }
 
 // tryNotDeepEqual try to testing the deeply inequality between actual and expect values, and it'll
 // fail if the values are deeply equal.
-func tryNotDeepEqual(t *testing.T, failedNow bool, actual, expect any, message ...any) error {
+func tryNotDeepEqual(t testingT, failedNow bool, actual, expect any, message ...any) error {
 	t.Helper()
 
 	return test(
 		t,
 		func() bool { return !reflect.DeepEqual(actual, expect) },
 		failedNow,
-		fmt.Sprintf(defaultErrMessageNotEqual, actual, expect),
+		getNotEqualMessage(actual, expect),
 		message...,
 	)
 }
@@ -102,7 +102,7 @@ func tryNotDeepEqual(t *testing.T, failedNow bool, actual, expect any, message .
 func (a *Assertion) Equal(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryEqual(a.T, false, actual, expect, message...)
+	return tryEqual(a.testingT(), false, actual, expect, message...)
 }
 
 // EqualNow tests the equality between actual and expect parameters, and it'll stop the execution
@@ -117,7 +117,7 @@ func (a *Assertion) Equal(actual, expect any, message ...any) error {
 func (a *Assertion) EqualNow(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryEqual(a.T, true, actual, expect, message...)
+	return tryEqual(a.testingT(), true, actual, expect, message...)
 }
 
 // NotEqual tests the inequality between actual and expected parameters. It'll set the result to
@@ -133,7 +133,7 @@ func (a *Assertion) EqualNow(actual, expect any, message ...any) error {
 func (a *Assertion) NotEqual(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotEqual(a.T, false, actual, expect, message...)
+	return tryNotEqual(a.testingT(), false, actual, expect, message...)
 }
 
 // NotEqualNow tests the inequality between actual and expected parameters, and it'll stop the
@@ -147,37 +147,76 @@ func (a *Assertion) NotEqual(actual, expect any, message ...any) error {
 func (a *Assertion) NotEqualNow(actual, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotEqual(a.T, true, actual, expect, message...)
+	return tryNotEqual(a.testingT(), true, actual, expect, message...)
 }
 
 // tryEqual try to testing the equality between actual and expect values, and it'll fail if the
 // values are not equal.
-func tryEqual(t *testing.T, failedNow bool, actual, expect any, message ...any) error {
+func tryEqual(t testingT, failedNow bool, actual, expect any, message ...any) error {
 	t.Helper()
 
 	return test(
 		t,
 		func() bool { return isEqual(actual, expect) },
 		failedNow,
-		fmt.Sprintf(defaultErrMessageEqual, actual, expect),
+		getEqualMessage(actual, expect),
 		message...,
 	)
 }
 
 // tryNotEqual try to testing the inequality between actual and expect values, and it'll fail if
 // the values are equal.
-func tryNotEqual(t *testing.T, failedNow bool, actual, expect any, message ...any) error {
+func tryNotEqual(t testingT, failedNow bool, actual, expect any, message ...any) error {
 	t.Helper()
 
 	return test(
 		t,
 		func() bool { return !isEqual(actual, expect) },
 		failedNow,
-		fmt.Sprintf(defaultErrMessageNotEqual, actual, expect),
+		getNotEqualMessage(actual, expect),
 		message...,
 	)
 }
 
+// getEqualMessage returns the default message of the equality assertions. It'll be the line-based
+// diff if both values are strings and any of them has multiple lines, unless the texts have too
+// many differences to compute the diff.
+func getEqualMessage(actual, expect any) string {
+	if actualText, expectText, ok := getMultilineTexts(actual, expect); ok {
+		if diff, ok := getTextDiff(expectText, actualText); ok && diff != "" {
+			return fmt.Sprintf(defaultErrMessageTextEqual, diff)
+		}
+	}
+
+	return fmt.Sprintf(defaultErrMessageEqual, actual, expect)
+}
+
+// getNotEqualMessage returns the default message of the inequality assertions. It'll print the
+// text once if both values are strings and any of them has multiple lines.
+func getNotEqualMessage(actual, expect any) string {
+	if actualText, _, ok := getMultilineTexts(actual, expect); ok {
+		return fmt.Sprintf(defaultErrMessageTextNotEqual, actualText)
+	}
+
+	return fmt.Sprintf(defaultErrMessageNotEqual, actual, expect)
+}
+
+// getMultilineTexts returns the strings of the values, and whether both values are strings and any
+// of them has multiple lines.
+func getMultilineTexts(actual, expect any) (string, string, bool) {
+	actualValue := reflect.ValueOf(actual)
+	expectValue := reflect.ValueOf(expect)
+
+	if actualValue.Kind() != reflect.String || expectValue.Kind() != reflect.String {
+		return "", "", false
+	}
+
+	actualText, expectText := actualValue.String(), expectValue.String()
+
+	return actualText, expectText,
+		strings.Contains(actualText, "\n") || strings.Contains(expectText, "\n")
+}
+
 // FloatEqual tests the equality between actual and expect floating numbers with epsilon. It'll
 // set the result to fail if they are not equal, and it doesn't stop the execution.
 //
@@ -188,7 +227,7 @@ func tryNotEqual(t *testing.T, failedNow bool, actual, expect any, message ...an
 func (a *Assertion) FloatEqual(actual, expect, epsilon any, message ...any) error {
 	a.Helper()
 
-	return tryFloatEqual(a.T, false, actual, expect, epsilon, message...)
+	return tryFloatEqual(a.testingT(), false, actual, expect, epsilon, message...)
 }
 
 // FloatEqualNow tests the equality between actual and expect floating numbers with epsilon, and
@@ -201,7 +240,7 @@ func (a *Assertion) FloatEqual(actual, expect, epsilon any, message ...any) erro
 func (a *Assertion) FloatEqualNow(actual, expect, epsilon any, message ...any) error {
 	a.Helper()
 
-	return tryFloatEqual(a.T, true, actual, expect, epsilon, message...)
+	return tryFloatEqual(a.testingT(), true, actual, expect, epsilon, message...)
 }
 
 // FloatNotEqual tests the inequality between actual and expect floating numbers with epsilon. It'll
@@ -214,7 +253,7 @@ func (a *Assertion) FloatEqualNow(actual, expect, epsilon any, message ...any) e
 func (a *Assertion) FloatNotEqual(actual, expect, epsilon any, message ...any) error {
 	a.Helper()
 
-	return tryFloatNotEqual(a.T, false, actual, expect, epsilon, message...)
+	return tryFloatNotEqual(a.testingT(), false, actual, expect, epsilon, message...)
 }
 
 // FloatNotEqualNow tests the inequality between actual and expect floating numbers with epsilon,
@@ -227,12 +266,12 @@ func (a *Assertion) FloatNotEqual(actual, expect, epsilon any, message ...any) e
 func (a *Assertion) FloatNotEqualNow(actual, expect, epsilon any, message ...any) error {
 	a.Helper()
 
-	return tryFloatNotEqual(a.T, true, actual, expect, epsilon, message...)
+	return tryFloatNotEqual(a.testingT(), true, actual, expect, epsilon, message...)
 }
 
 // tryFloatEqual try to testing the equality between actual and expect floating numbers, and it'll
 // fail if the values are not equal.
-func tryFloatEqual(t *testing.T, failedNow bool, actual, expect, epsilon any, message ...any) error {
+func tryFloatEqual(t testingT, failedNow bool, actual, expect, epsilon any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -246,7 +285,7 @@ func tryFloatEqual(t *testing.T, failedNow bool, actual, expect, epsilon any, me
 
 // tryFloatNotEqual try to testing the inequality between actual and expect floating numbers, and
 // it'll fail if the values are equal.
-func tryFloatNotEqual(t *testing.T, failedNow bool, actual, expect, epsilon any, message ...any) error {
+func tryFloatNotEqual(t testingT, failedNow bool, actual, expect, epsilon any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -271,7 +310,7 @@ func tryFloatNotEqual(t *testing.T, failedNow bool, actual, expect, epsilon any,
 func (a *Assertion) Nil(val any, message ...any) error {
 	a.Helper()
 
-	return tryNil(a.T, false, val, message...)
+	return tryNil(a.testingT(), false, val, message...)
 }
 
 // NilNow tests whether a value is nil or not, and it'll fail when the value is not nil. It will
@@ -290,7 +329,7 @@ func (a *Assertion) Nil(val any, message ...any) error {
 func (a *Assertion) NilNow(val any, message ...any) error {
 	a.Helper()
 
-	return tryNil(a.T, true, val, message...)
+	return tryNil(a.testingT(), true, val, message...)
 }
 
 // NotNil tests whether a value is nil or not, and it'll fail when the value is nil. It will
@@ -306,7 +345,7 @@ func (a *Assertion) NilNow(val any, message ...any) error {
 func (a *Assertion) NotNil(val any, message ...any) error {
 	a.Helper()
 
-	return tryNotNil(a.T, false, val, message...)
+	return tryNotNil(a.testingT(), false, val, message...)
 }
 
 // NotNilNow tests whether a value is nil or not, and it'll fail when the value is nil. It will
@@ -325,11 +364,11 @@ func (a *Assertion) NotNil(val any, message ...any) error {
 func (a *Assertion) NotNilNow(val any, message ...any) error {
 	a.Helper()
 
-	return tryNotNil(a.T, true, val, message...)
+	return tryNotNil(a.testingT(), true, val, message...)
 }
 
 // tryNil try to testing a value is nil or not, and it'll fail the value is nil.
-func tryNil(t *testing.T, failedNow bool, val any, message ...any) error {
+func tryNil(t testingT, failedNow bool, val any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -342,7 +381,7 @@ func tryNil(t *testing.T, failedNow bool, val any, message ...any) error {
 }
 
 // tryNotNil try to testing a value is nil or not, and it'll fail the value is not nil.
-func tryNotNil(t *testing.T, failedNow bool, val any, message ...any) error {
+func tryNotNil(t testingT, failedNow bool, val any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -367,7 +406,7 @@ func tryNotNil(t *testing.T, failedNow bool, val any, message ...any) error {
 func (a *Assertion) True(val any, message ...any) error {
 	a.Helper()
 
-	return tryTrue(a.T, false, val, message...)
+	return tryTrue(a.testingT(), false, val, message...)
 }
 
 // TrueNow tests whether a value is truthy or not. It'll set the result to fail if the value is a
@@ -385,7 +424,7 @@ func (a *Assertion) True(val any, message ...any) error {
 func (a *Assertion) TrueNow(val any, message ...any) error {
 	a.Helper()
 
-	return tryTrue(a.T, true, val, message...)
+	return tryTrue(a.testingT(), true, val, message...)
 }
 
 // NotTrue tests whether a value is truthy or not. It'll set the result to fail if the value is a
@@ -401,7 +440,7 @@ func (a *Assertion) TrueNow(val any, message ...any) error {
 func (a *Assertion) NotTrue(val any, message ...any) error {
 	a.Helper()
 
-	return tryNotTrue(a.T, false, val, message...)
+	return tryNotTrue(a.testingT(), false, val, message...)
 }
 
 // NotTrueNow tests whether a value is truthy or not. It'll set the result to fail if the value is
@@ -419,11 +458,11 @@ func (a *Assertion) NotTrue(val any, message ...any) error {
 func (a *Assertion) NotTrueNow(val any, message ...any) error {
 	a.Helper()
 
-	return tryNotTrue(a.T, true, val, message...)
+	return tryNotTrue(a.testingT(), true, val, message...)
 }
 
 // tryTrue try to testing a value is truthy or falsy, and it'll fail the value is falsy.
-func tryTrue(t *testing.T, failedNow bool, val any, message ...any) error {
+func tryTrue(t testingT, failedNow bool, val any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -438,7 +477,7 @@ func tryTrue(t *testing.T, failedNow bool, val any, message ...any) error {
 }
 
 // tryNotTrue try to testing a value is truthy or falsy, and it'll fail the value is truthy.
-func tryNotTrue(t *testing.T, failedNow bool, val any, message ...any) error {
+func tryNotTrue(t testingT, failedNow bool, val any, message ...any) error {
 	t.Helper()
 
 	return test(
@@ -452,6 +491,189 @@ func tryNotTrue(t *testing.T, failedNow bool, val any, message ...any) error {
 	)
 }
 
+// Zero tests whether the value is the zero value for its type, and it'll set the result to fail if
+// the value is not the zero value. Unlike True, it uses the `reflect.Value.IsZero` semantics
+// without special cases, so an empty but non-nil slice or map is not zero. For nil, the value is
+// always zero.
+//
+//	a := assert.New(t)
+//	a.Zero(0) // success
+//	a.Zero([]int(nil)) // success
+//	a.Zero(1) // fail
+//	a.Zero([]int{}) // fail
+func (a *Assertion) Zero(val any, message ...any) error {
+	a.Helper()
+
+	return tryZero(a.testingT(), false, val, message...)
+}
+
+// ZeroNow tests whether the value is the zero value for its type, and it'll stop the execution if
+// the value is not the zero value.
+//
+//	a := assert.New(t)
+//	a.ZeroNow(0) // success
+//	a.ZeroNow([]int{}) // fail and terminate
+//	// never run
+func (a *Assertion) ZeroNow(val any, message ...any) error {
+	a.Helper()
+
+	return tryZero(a.testingT(), true, val, message...)
+}
+
+// NotZero tests whether the value is the zero value for its type, and it'll set the result to fail
+// if the value is the zero value. Unlike True, an empty but non-nil slice or map is not zero.
+//
+//	a := assert.New(t)
+//	a.NotZero(1) // success
+//	a.NotZero([]int{}) // success
+//	a.NotZero(0) // fail
+//	a.NotZero(nil) // fail
+func (a *Assertion) NotZero(val any, message ...any) error {
+	a.Helper()
+
+	return tryNotZero(a.testingT(), false, val, message...)
+}
+
+// NotZeroNow tests whether the value is the zero value for its type, and it'll stop the execution
+// if the value is the zero value.
+//
+//	a := assert.New(t)
+//	a.NotZeroNow(1) // success
+//	a.NotZeroNow(0) // fail and terminate
+//	// never run
+func (a *Assertion) NotZeroNow(val any, message ...any) error {
+	a.Helper()
+
+	return tryNotZero(a.testingT(), true, val, message...)
+}
+
+// Same tests whether the values refer to the same underlying object, and it'll set the result to
+// fail with their types and addresses if they don't. The values must be the pointers, the maps,
+// the slices, the channels, the functions, or the untyped nil, and it'll panic if any of them is
+// not. The values of different types are never the same, and the slices are the same only if they
+// start at the same address and have the same length. The untyped nil, the non-nil slices with zero
+// capacity, and the non-nil pointers to zero-size values are never the same as any value, because
+// they don't refer to a distinct object. The functions are compared by their code pointers, so the
+// closures created by the same function literal are the same even if they capture different
+// variables.
+//
+//	a := assert.New(t)
+//	p1 := new(int)
+//	p2 := new(int)
+//	a.Same(p1, p1) // success
+//	a.Same(p1, p2) // fail
+func (a *Assertion) Same(actual, expect any, message ...any) error {
+	a.Helper()
+
+	return trySame(a.testingT(), false, actual, expect, message...)
+}
+
+// SameNow tests whether the values refer to the same underlying object, and it'll stop the
+// execution if they don't. It'll panic if any value is not a pointer, a map, a slice, a channel,
+// a function, or the untyped nil.
+//
+//	a := assert.New(t)
+//	p1 := new(int)
+//	p2 := new(int)
+//	a.SameNow(p1, p1) // success
+//	a.SameNow(p1, p2) // fail and terminate
+//	// never run
+func (a *Assertion) SameNow(actual, expect any, message ...any) error {
+	a.Helper()
+
+	return trySame(a.testingT(), true, actual, expect, message...)
+}
+
+// NotSame tests whether the values refer to the same underlying object, and it'll set the result
+// to fail with their type and address if they do. It'll panic if any value is not a pointer, a
+// map, a slice, a channel, a function, or the untyped nil. The functions are compared by their code
+// pointers, so it always fails for the closures created by the same function literal.
+//
+//	a := assert.New(t)
+//	p1 := new(int)
+//	p2 := new(int)
+//	a.NotSame(p1, p2) // success
+//	a.NotSame(p1, p1) // fail
+func (a *Assertion) NotSame(actual, expect any, message ...any) error {
+	a.Helper()
+
+	return tryNotSame(a.testingT(), false, actual, expect, message...)
+}
+
+// NotSameNow tests whether the values refer to the same underlying object, and it'll stop the
+// execution if they do. It'll panic if any value is not a pointer, a map, a slice, a channel, a
+// function, or the untyped nil.
+//
+//	a := assert.New(t)
+//	p1 := new(int)
+//	p2 := new(int)
+//	a.NotSameNow(p1, p2) // success
+//	a.NotSameNow(p1, p1) // fail and terminate
+//	// never run
+func (a *Assertion) NotSameNow(actual, expect any, message ...any) error {
+	a.Helper()
+
+	return tryNotSame(a.testingT(), true, actual, expect, message...)
+}
+
+// tryZero tries to test whether the value is the zero value, and it'll fail if the value is not
+// the zero value.
+func tryZero(t testingT, failedNow bool, val any, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isZero(val) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageZero, val, val),
+		message...,
+	)
+}
+
+// tryNotZero tries to test whether the value is the zero value, and it'll fail if the value is the
+// zero value.
+func tryNotZero(t testingT, failedNow bool, val any, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return !isZero(val) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageNotZero, val),
+		message...,
+	)
+}
+
+// trySame tries to test whether the values refer to the same object, and it'll fail if they don't.
+func trySame(t testingT, failedNow bool, actual, expect any, message ...any) error {
+	t.Helper()
+
+	isSameObject := isSame(actual, expect)
+
+	return test(
+		t,
+		func() bool { return isSameObject },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageSame, formatReference(expect), formatReference(actual)),
+		message...,
+	)
+}
+
+// tryNotSame tries to test whether the values refer to the same object, and it'll fail if they do.
+func tryNotSame(t testingT, failedNow bool, actual, expect any, message ...any) error {
+	t.Helper()
+
+	isSameObject := isSame(actual, expect)
+
+	return test(
+		t,
+		func() bool { return !isSameObject },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageNotSame, formatReference(actual)),
+		message...,
+	)
+}
+
 // isEqual checks the equality of the values.
 func isEqual(x, y any) bool {
 	if x == nil || y == nil {
@@ -493,8 +715,16 @@ func isEqual(x, y any) bool {
 	}
 }
 
-// isFloatEqual checks the equality of two floating numbers with epsilon.
+// isFloatEqual checks the equality of two floating numbers with epsilon. For complex numbers, it
+// checks both the real parts and the imaginary parts with epsilon.
 func isFloatEqual(x, y, epsilon any) bool {
+	if isComplex(x) || isComplex(y) {
+		xc := toComplex(x)
+		yc := toComplex(y)
+
+		return isFloatEqual(real(xc), real(yc), epsilon) && isFloatEqual(imag(xc), imag(yc), epsilon)
+	}
+
 	xv := toFloat(x)
 	yv := toFloat(y)
 	ev := toFloat(epsilon)
@@ -544,3 +774,69 @@ func isTrue(v any) bool {
 		return !rv.IsZero()
 	}
 }
+
+// isZero checks whether a value is the zero value for its type by `reflect.Value.IsZero`. For nil,
+// it'll always return true.
+func isZero(v any) bool {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return true
+	}
+
+	return rv.IsZero()
+}
+
+// isSame checks whether the values refer to the same underlying object. The untyped nil, the
+// non-nil slices with zero capacity, and the non-nil pointers to zero-size values are never the
+// same, because the runtime may place the zero-size allocations at the same address. The functions
+// are compared by their code pointers. It'll panic if any value is not a pointer, a map, a slice, a
+// channel, a function, or the untyped nil.
+func isSame(x, y any) bool {
+	v1 := getReferenceValue(x)
+	v2 := getReferenceValue(y)
+
+	if !v1.IsValid() || !v2.IsValid() {
+		return false
+	} else if v1.Type() != v2.Type() || v1.Pointer() != v2.Pointer() {
+		return false
+	} else if v1.IsNil() {
+		return true
+	}
+
+	switch v1.Kind() {
+	case reflect.Slice:
+		return v1.Cap() > 0 && v2.Cap() > 0 && v1.Len() == v2.Len()
+	case reflect.Pointer:
+		return v1.Type().Elem().Size() > 0
+	default:
+		return true
+	}
+}
+
+// getReferenceValue returns the reflection value of the reference, and it'll panic if the value is
+// not a pointer, a map, a slice, a channel, a function, or the untyped nil. The reflection value of the untyped
+// nil is the zero value.
+func getReferenceValue(v any) reflect.Value {
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Invalid, reflect.Pointer, reflect.UnsafePointer, reflect.Map, reflect.Slice,
+		reflect.Chan, reflect.Func:
+		return rv
+	default:
+		panic(ErrNotReference)
+	}
+}
+
+// formatReference formats the type and the address of the reference, and the length for a slice.
+func formatReference(v any) string {
+	rv := getReferenceValue(v)
+
+	if !rv.IsValid() {
+		return "<nil>"
+	} else if rv.Kind() == reflect.Slice {
+		return fmt.Sprintf("%s(%#x, len %d)", getFullTypeName(rv.Type()), rv.Pointer(), rv.Len())
+	}
+
+	return fmt.Sprintf("%s(%#x)", getFullTypeName(rv.Type()), rv.Pointer())
+}
@@ -1,6 +1,8 @@
 package assert
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -146,6 +148,9 @@ func TestFloatEqualAndFloatNotEqual(t *testing.T) {
 	testFloatEqualAndFloatNotEqual(a, mockA, 1.00000000001, 1, 1e-7, true)
 	testFloatEqualAndFloatNotEqual(a, mockA, 1.00001, 1, 1e-7, false)
 	testFloatEqualAndFloatNotEqual(a, mockA, 0.9999, 1, 1e-7, false)
+	testFloatEqualAndFloatNotEqual(a, mockA, complex(1, 1), complex(1, 1.00000000001), 1e-7, true)
+	testFloatEqualAndFloatNotEqual(a, mockA, complex(1, 1), complex64(complex(1, 1.1)), 1e-7, false)
+	testFloatEqualAndFloatNotEqual(a, mockA, complex(1, 0), 1, 1e-7, true)
 }
 
 func testFloatEqualAndFloatNotEqual(a, mockA *Assertion, v1, v2, epsilon any, isEqual bool) {
@@ -377,3 +382,236 @@ func TestIsTrue(t *testing.T) {
 	assert.NotTrue(isTrue(""))
 	assert.True(isTrue(func() {}))
 }
+
+func TestEqualMultilineTextMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.Equal("Hello\nworld\n", "Hello\nthere\n")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect texts equal:
+
+--- expected
++++ actual
+@@ -1,2 +1,2 @@
+1 1  Hello
+2   -there
+  2 +world`)
+
+	err = mockA.DeepEqual("Hello\nworld", "Hello")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect texts equal:
+
+--- expected
++++ actual
+@@ -1 +1,2 @@
+1   -Hello
+\ No newline at end of file
+  1 +Hello
+  2 +world
+\ No newline at end of file`)
+
+	err = mockA.NotEqual("Hello\nworld", "Hello\nworld")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect texts not equal, got:\n\nHello\nworld")
+
+	err = mockA.Equal("Hello", "world")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: Hello == world")
+}
+
+func TestZeroAndNotZero(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	var nilPtr *int
+	var nilSlice []int
+
+	testZeroAndNotZero(a, mockA, nil, true)
+	testZeroAndNotZero(a, mockA, 0, true)
+	testZeroAndNotZero(a, mockA, 1, false)
+	testZeroAndNotZero(a, mockA, "", true)
+	testZeroAndNotZero(a, mockA, "test", false)
+	testZeroAndNotZero(a, mockA, false, true)
+	testZeroAndNotZero(a, mockA, nilPtr, true)
+	testZeroAndNotZero(a, mockA, new(int), false)
+	testZeroAndNotZero(a, mockA, nilSlice, true)
+	testZeroAndNotZero(a, mockA, []int{}, false)
+	testZeroAndNotZero(a, mockA, map[string]int{}, false)
+	testZeroAndNotZero(a, mockA, testStruct{}, true)
+	testZeroAndNotZero(a, mockA, testStruct{v: 1}, false)
+}
+
+func testZeroAndNotZero(a, mockA *Assertion, val any, isZero bool) {
+	a.T.Helper()
+
+	// Zero
+	testAssertionFunction(a, "Zero", func() error {
+		return Zero(mockA.T, val)
+	}, isZero)
+	testAssertionFunction(a, "Assertion.Zero", func() error {
+		return mockA.Zero(val)
+	}, isZero)
+
+	// NotZero
+	testAssertionFunction(a, "NotZero", func() error {
+		return NotZero(mockA.T, val)
+	}, !isZero)
+	testAssertionFunction(a, "Assertion.NotZero", func() error {
+		return mockA.NotZero(val)
+	}, !isZero)
+
+	// ZeroNow
+	testAssertionNowFunction(a, "ZeroNow", func() {
+		ZeroNow(mockA.T, val)
+	}, !isZero)
+	testAssertionNowFunction(a, "Assertion.ZeroNow", func() {
+		mockA.ZeroNow(val)
+	}, !isZero)
+
+	// NotZeroNow
+	testAssertionNowFunction(a, "NotZeroNow", func() {
+		NotZeroNow(mockA.T, val)
+	}, isZero)
+	testAssertionNowFunction(a, "Assertion.NotZeroNow", func() {
+		mockA.NotZeroNow(val)
+	}, isZero)
+}
+
+func TestSameAndNotSame(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	p1 := new(int)
+	p2 := new(int)
+	var nilPtr1, nilPtr2 *int
+	m := map[string]int{}
+	s := []int{1, 2, 3}
+	ch := make(chan int)
+
+	testSameAndNotSame(a, mockA, p1, p1, true)
+	testSameAndNotSame(a, mockA, p1, p2, false)
+	testSameAndNotSame(a, mockA, nilPtr1, nilPtr2, true)
+	testSameAndNotSame(a, mockA, p1, (*int64)(nil), false)
+	testSameAndNotSame(a, mockA, m, m, true)
+	testSameAndNotSame(a, mockA, m, map[string]int{}, false)
+	testSameAndNotSame(a, mockA, s, s, true)
+	testSameAndNotSame(a, mockA, s, s[:2], false)
+	testSameAndNotSame(a, mockA, s, []int{1, 2, 3}, false)
+	testSameAndNotSame(a, mockA, ch, ch, true)
+	testSameAndNotSame(a, mockA, ch, make(chan int), false)
+	testSameAndNotSame(a, mockA, p1, nil, false)
+	testSameAndNotSame(a, mockA, nil, nilPtr1, false)
+	testSameAndNotSame(a, mockA, nil, nil, false)
+
+	a.PanicOfNow(func() {
+		mockA.Same(1, 1)
+	}, ErrNotReference)
+	a.PanicOfNow(func() {
+		mockA.NotSame(1, nil)
+	}, ErrNotReference)
+}
+
+func TestSameWithFunctions(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	mk := func(n int) func() int {
+		return func() int { return n }
+	}
+	fn := mk(1)
+	var nilFn1, nilFn2 func() int
+
+	testSameAndNotSame(a, mockA, fn, fn, true)
+	testSameAndNotSame(a, mockA, strings.ToUpper, strings.ToUpper, true)
+	testSameAndNotSame(a, mockA, strings.ToUpper, strings.ToLower, false)
+	testSameAndNotSame(a, mockA, nilFn1, nilFn2, true)
+	testSameAndNotSame(a, mockA, fn, nilFn1, false)
+	testSameAndNotSame(a, mockA, fn, nil, false)
+	// the closures created by the same function literal share the same code pointer.
+	testSameAndNotSame(a, mockA, mk(1), mk(2), true)
+}
+
+func TestSameWithZeroSizeObjects(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	s := []int{}
+	var nilSlice []int
+	p := new(struct{})
+	arr := [0]int{}
+
+	testSameAndNotSame(a, mockA, []int{}, make([]int, 0), false)
+	testSameAndNotSame(a, mockA, s, s, false)
+	testSameAndNotSame(a, mockA, nilSlice, nilSlice, true)
+	testSameAndNotSame(a, mockA, new(struct{}), new(struct{}), false)
+	testSameAndNotSame(a, mockA, p, p, false)
+	testSameAndNotSame(a, mockA, &arr, &arr, false)
+	testSameAndNotSame(a, mockA, make([]int, 0, 1), make([]int, 0, 1), false)
+}
+
+func testSameAndNotSame(a, mockA *Assertion, v1, v2 any, isSame bool) {
+	a.T.Helper()
+
+	// Same
+	testAssertionFunction(a, "Same", func() error {
+		return Same(mockA.T, v1, v2)
+	}, isSame)
+	testAssertionFunction(a, "Assertion.Same", func() error {
+		return mockA.Same(v1, v2)
+	}, isSame)
+
+	// NotSame
+	testAssertionFunction(a, "NotSame", func() error {
+		return NotSame(mockA.T, v1, v2)
+	}, !isSame)
+	testAssertionFunction(a, "Assertion.NotSame", func() error {
+		return mockA.NotSame(v1, v2)
+	}, !isSame)
+
+	// SameNow
+	testAssertionNowFunction(a, "SameNow", func() {
+		SameNow(mockA.T, v1, v2)
+	}, !isSame)
+	testAssertionNowFunction(a, "Assertion.SameNow", func() {
+		mockA.SameNow(v1, v2)
+	}, !isSame)
+
+	// NotSameNow
+	testAssertionNowFunction(a, "NotSameNow", func() {
+		NotSameNow(mockA.T, v1, v2)
+	}, isSame)
+	testAssertionNowFunction(a, "Assertion.NotSameNow", func() {
+		mockA.NotSameNow(v1, v2)
+	}, isSame)
+}
+
+func TestZeroAndSameMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.Zero([]int{})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect zero value, got [] ([]int)")
+
+	err = mockA.NotZero(nil)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect non-zero value, got zero value of <nil>")
+
+	p1 := new(int)
+	p2 := new(int)
+	err = mockA.Same(p1, p2)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), fmt.Sprintf(
+		"assert error: expect same object, expected *int(%p), got *int(%p)", p2, p1,
+	))
+
+	s := []testStruct{{v: 1}}
+	err = mockA.NotSame(s, s)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), fmt.Sprintf(
+		"assert error: expect different objects, got "+
+			"[]github.com/ghosind/go-assert.testStruct(%p, len 1) for both",
+		s,
+	))
+}
@@ -0,0 +1,401 @@
+package assert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// diffContextLines is the number of the unchanged lines around the changes in the diff.
+	diffContextLines = 3
+	// maxDiffEditDistance is the maximum number of the changed lines to find the shortest edit
+	// script, the diff will not be computed if the texts have more differences.
+	maxDiffEditDistance = 1000
+	// maxDiffOutputLines is the maximum number of the lines of the rendered diff, and the rest lines
+	// will be truncated.
+	maxDiffOutputLines = 200
+)
+
+// diffOp is the operation of a line in the diff.
+type diffOp int
+
+const (
+	// diffOpEqual indicates the line is in both the expected and the actual texts.
+	diffOpEqual diffOp = iota
+	// diffOpDelete indicates the line is only in the expected text.
+	diffOpDelete
+	// diffOpInsert indicates the line is only in the actual text.
+	diffOpInsert
+)
+
+// diffLine is a line in the diff.
+type diffLine struct {
+	// op is the operation of the line.
+	op diffOp
+	// text is the content of the line.
+	text string
+	// expectedLine is the line number in the expected text, and it's zero for the inserted lines.
+	expectedLine int
+	// actualLine is the line number in the actual text, and it's zero for the deleted lines.
+	actualLine int
+}
+
+// formatTextDiff formats the line-based difference between the expected and the actual texts as a
+// unified diff with the line numbers, and returns an empty string if the texts are the same. It
+// only reports the line of the first difference if the texts have too many differences.
+func formatTextDiff(expected, actual string) string {
+	diff, ok := getTextDiff(expected, actual)
+	if !ok {
+		return fmt.Sprintf(
+			"--- expected\n+++ actual\n(too many differences to show, first difference at line %d)",
+			getFirstDifferentLine(splitTextLines(expected), splitTextLines(actual)),
+		)
+	}
+
+	return diff
+}
+
+// getTextDiff formats the line-based difference between the expected and the actual texts as a
+// unified diff with the line numbers, and the diff will be truncated if it's too long. It returns
+// an empty string if the texts are the same, and false if the texts have too many differences to
+// compute the diff.
+func getTextDiff(expected, actual string) (string, bool) {
+	if expected == actual {
+		return "", true
+	}
+
+	lines, ok := getLineDiff(splitTextLines(expected), splitTextLines(actual))
+	if !ok {
+		return "", false
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("--- expected\n+++ actual")
+
+	for _, hunk := range getDiffHunks(lines, diffContextLines) {
+		writeDiffHunk(&builder, lines, hunk[0], hunk[1])
+	}
+
+	return truncateLines(builder.String(), maxDiffOutputLines), true
+}
+
+// truncateLines keeps up to n lines of the text, and appends the number of the truncated lines if
+// the text has more lines.
+func truncateLines(text string, n int) string {
+	lines := strings.SplitN(text, "\n", n+1)
+	if len(lines) <= n {
+		return text
+	}
+
+	rest := strings.Count(lines[n], "\n") + 1
+
+	return strings.Join(lines[:n], "\n") + fmt.Sprintf("\n... (%d more lines)", rest)
+}
+
+// getFirstDifferentLine returns the line number of the first different line of the texts.
+func getFirstDifferentLine(expected, actual []string) int {
+	i := 0
+	for i < len(expected) && i < len(actual) && expected[i] == actual[i] {
+		i++
+	}
+
+	return i + 1
+}
+
+// splitTextLines splits the text into lines, and each line keeps its line terminator.
+func splitTextLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
+
+// getLineDiff returns the shortest edit script that converts the expected lines to the actual
+// lines by the Myers' diff algorithm. The common prefix and suffix lines are skipped before
+// searching, and it returns false if the edit distance exceeds maxDiffEditDistance.
+func getLineDiff(expected, actual []string) ([]diffLine, bool) {
+	prefix := 0
+	for prefix < len(expected) && prefix < len(actual) && expected[prefix] == actual[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(expected)-prefix && suffix < len(actual)-prefix &&
+		expected[len(expected)-1-suffix] == actual[len(actual)-1-suffix] {
+		suffix++
+	}
+
+	changes, ok := getMiddleLineDiff(
+		expected[prefix:len(expected)-suffix], actual[prefix:len(actual)-suffix], prefix,
+	)
+	if !ok {
+		return nil, false
+	}
+
+	lines := make([]diffLine, 0, prefix+len(changes)+suffix)
+	for i := 0; i < prefix; i++ {
+		lines = append(lines, newEqualDiffLine(expected[i], i+1, i+1))
+	}
+	lines = append(lines, changes...)
+	for i := suffix; i > 0; i-- {
+		x, y := len(expected)-i, len(actual)-i
+		lines = append(lines, newEqualDiffLine(expected[x], x+1, y+1))
+	}
+
+	return lines, true
+}
+
+// getMiddleLineDiff returns the shortest edit script of the lines after the common prefix lines,
+// and the line numbers start after the prefix. It only keeps the diagonals that are reachable in
+// each step for backtracking, so the memory is proportional to the square of the edit distance.
+func getMiddleLineDiff(expected, actual []string, prefix int) ([]diffLine, bool) {
+	n, m := len(expected), len(actual)
+	maxD := minInt(n+m, maxDiffEditDistance)
+	offset := maxD + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] is the furthest x of the diagonals from -d to d before the step d.
+	trace := make([][]int, 0)
+
+	d := 0
+	found := false
+search:
+	for ; d <= maxD; d++ {
+		trace = append(trace, append([]int{}, v[offset-d:offset+d+1]...))
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+
+			for x < n && y < m && expected[x] == actual[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+
+			if x >= n && y >= m {
+				found = true
+				break search
+			}
+		}
+	}
+	if !found {
+		return nil, false
+	}
+
+	lines := make([]diffLine, 0, n+m)
+	x, y := n, m
+	for ; d > 0; d-- {
+		tv := trace[d]
+		k := x - y
+
+		var prevK int
+		if k == -d || (k != d && tv[k-1+d] < tv[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := tv[prevK+d]
+		prevY := prevX - prevK
+
+		for x > prevX && y > prevY {
+			lines = append(lines, newEqualDiffLine(expected[x-1], prefix+x, prefix+y))
+			x--
+			y--
+		}
+
+		if x == prevX {
+			lines = append(lines, diffLine{
+				op: diffOpInsert, text: actual[y-1], actualLine: prefix + y,
+			})
+		} else {
+			lines = append(lines, diffLine{
+				op: diffOpDelete, text: expected[x-1], expectedLine: prefix + x,
+			})
+		}
+
+		x, y = prevX, prevY
+	}
+	for x > 0 && y > 0 {
+		lines = append(lines, newEqualDiffLine(expected[x-1], prefix+x, prefix+y))
+		x--
+		y--
+	}
+
+	for i, j := 0, len(lines)-1; i < j; i, j = i+1, j-1 {
+		lines[i], lines[j] = lines[j], lines[i]
+	}
+
+	return lines, true
+}
+
+// getDiffHunks groups the changed lines with the unchanged lines around them into hunks, and
+// returns the ranges of the hunks. The changes that are close to each other will be merged into
+// the same hunk.
+func getDiffHunks(lines []diffLine, context int) [][2]int {
+	hunks := make([][2]int, 0)
+	start, end := -1, -1
+
+	for i, line := range lines {
+		if line.op == diffOpEqual {
+			continue
+		}
+
+		if start >= 0 && i-context <= end {
+			end = minInt(i+context+1, len(lines))
+			continue
+		}
+
+		if start >= 0 {
+			hunks = append(hunks, [2]int{start, end})
+		}
+		start = maxInt(i-context, 0)
+		end = minInt(i+context+1, len(lines))
+	}
+
+	if start >= 0 {
+		hunks = append(hunks, [2]int{start, end})
+	}
+
+	return hunks
+}
+
+// writeDiffHunk writes the lines in the range with the header of the line numbers to the builder.
+func writeDiffHunk(builder *strings.Builder, lines []diffLine, start, end int) {
+	// the lines before the hunk
+	expectedStart, actualStart := 0, 0
+	for _, line := range lines[:start] {
+		expectedStart = maxInt(expectedStart, line.expectedLine)
+		actualStart = maxInt(actualStart, line.actualLine)
+	}
+
+	expectedCount, actualCount := 0, 0
+	for _, line := range lines[start:end] {
+		if line.op != diffOpInsert {
+			expectedCount++
+		}
+		if line.op != diffOpDelete {
+			actualCount++
+		}
+	}
+
+	builder.WriteString(fmt.Sprintf(
+		"\n@@ -%s +%s @@",
+		formatDiffRange(expectedStart, expectedCount),
+		formatDiffRange(actualStart, actualCount),
+	))
+
+	width := len(strconv.Itoa(maxInt(expectedStart+expectedCount, actualStart+actualCount)))
+	visibles := getWhitespaceChangedLines(lines[start:end])
+
+	for _, line := range lines[start:end] {
+		expectedLine, actualLine := "", ""
+		if line.expectedLine > 0 {
+			expectedLine = strconv.Itoa(line.expectedLine)
+		}
+		if line.actualLine > 0 {
+			actualLine = strconv.Itoa(line.actualLine)
+		}
+
+		text := strings.TrimSuffix(line.text, "\n")
+		if visibles[line.text] && line.op != diffOpEqual {
+			text = visualizeWhitespaces(text)
+		}
+
+		op := " "
+		switch line.op {
+		case diffOpDelete:
+			op = "-"
+		case diffOpInsert:
+			op = "+"
+		}
+
+		builder.WriteString(fmt.Sprintf(
+			"\n%*s %*s %s%s", width, expectedLine, width, actualLine, op, text,
+		))
+
+		if line.op != diffOpEqual && !strings.HasSuffix(line.text, "\n") {
+			builder.WriteString("\n\\ No newline at end of file")
+		}
+	}
+}
+
+// getWhitespaceChangedLines returns the changed lines that only have whitespace differences with
+// any changed line of the other side in the hunk, including the differences of the line
+// terminators.
+func getWhitespaceChangedLines(hunk []diffLine) map[string]bool {
+	deleted := make(map[string][]string)
+	inserted := make(map[string][]string)
+
+	for _, line := range hunk {
+		key := strings.Join(strings.Fields(line.text), "")
+		switch line.op {
+		case diffOpDelete:
+			deleted[key] = append(deleted[key], line.text)
+		case diffOpInsert:
+			inserted[key] = append(inserted[key], line.text)
+		}
+	}
+
+	lines := make(map[string]bool)
+	for key, texts := range deleted {
+		if others, ok := inserted[key]; ok {
+			for _, text := range append(texts, others...) {
+				lines[text] = true
+			}
+		}
+	}
+
+	return lines
+}
+
+// visualizeWhitespaces replaces the spaces, the tabs, and the carriage returns in the text with
+// the visible symbols.
+func visualizeWhitespaces(text string) string {
+	return strings.NewReplacer(" ", "·", "\t", "→", "\r", "␍").Replace(text)
+}
+
+// formatDiffRange formats the line range of the hunk by the number of lines before the hunk and
+// the number of lines in the hunk. An empty range starts at the line before the hunk.
+func formatDiffRange(before, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", before)
+	case 1:
+		return fmt.Sprintf("%d", before+1)
+	default:
+		return fmt.Sprintf("%d,%d", before+1, count)
+	}
+}
+
+// newEqualDiffLine returns an unchanged line with its line numbers in both texts.
+func newEqualDiffLine(text string, expectedLine, actualLine int) diffLine {
+	return diffLine{
+		op:           diffOpEqual,
+		text:         text,
+		expectedLine: expectedLine,
+		actualLine:   actualLine,
+	}
+}
+
+// minInt returns the smaller one of the integers.
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// maxInt returns the larger one of the integers.
+func maxInt(x, y int) int {
+	if x > y {
+		return x
+	}
+	return y
+}
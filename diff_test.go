@@ -0,0 +1,156 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGetLineDiff(t *testing.T) {
+	a := New(t)
+
+	testGetLineDiff(a, "", "", "")
+	testGetLineDiff(a, "a\nb\nc", "a\nb\nc", "a b c")
+	testGetLineDiff(a, "a\nb\nc", "a\nc", "a -b c")
+	testGetLineDiff(a, "a\nc", "a\nb\nc", "a +b c")
+	testGetLineDiff(a, "a\nb\nc", "x\ny\nz", "-a -b -c +x +y +z")
+	testGetLineDiff(a, "a\nb\nc\na\nb\nb\na", "c\nb\na\nb\na\nc", "-a -b c +b a b -b a +c")
+}
+
+func testGetLineDiff(a *Assertion, expected, actual, diff string) {
+	a.Helper()
+
+	lines, ok := getLineDiff(strings.Split(expected, "\n"), strings.Split(actual, "\n"))
+	a.TrueNow(ok)
+
+	ops := make([]string, 0, len(lines))
+	expectedLines := make([]string, 0, len(lines))
+	actualLines := make([]string, 0, len(lines))
+	for _, line := range lines {
+		switch line.op {
+		case diffOpDelete:
+			ops = append(ops, "-"+line.text)
+			expectedLines = append(expectedLines, line.text)
+			a.EqualNow(line.actualLine, 0)
+		case diffOpInsert:
+			ops = append(ops, "+"+line.text)
+			actualLines = append(actualLines, line.text)
+			a.EqualNow(line.expectedLine, 0)
+		default:
+			ops = append(ops, line.text)
+			expectedLines = append(expectedLines, line.text)
+			actualLines = append(actualLines, line.text)
+			a.EqualNow(line.expectedLine, len(expectedLines))
+			a.EqualNow(line.actualLine, len(actualLines))
+		}
+	}
+
+	a.EqualNow(strings.Join(ops, " "), diff)
+	a.EqualNow(strings.Join(expectedLines, "\n"), expected)
+	a.EqualNow(strings.Join(actualLines, "\n"), actual)
+}
+
+func TestGetLineDiffLimit(t *testing.T) {
+	a := New(t)
+
+	expected := make([]string, 0, maxDiffEditDistance*2)
+	actual := make([]string, 0, maxDiffEditDistance*2)
+	for i := 0; i < maxDiffEditDistance; i++ {
+		expected = append(expected, fmt.Sprintf("expected %d\n", i))
+		actual = append(actual, fmt.Sprintf("actual %d\n", i))
+	}
+
+	lines, ok := getLineDiff(expected[:maxDiffEditDistance/2], actual[:maxDiffEditDistance/2])
+	a.TrueNow(ok)
+	a.EqualNow(len(lines), maxDiffEditDistance)
+
+	_, ok = getLineDiff(expected, actual)
+	a.NotTrueNow(ok)
+
+	// the common prefix and suffix lines are not counted
+	same := strings.Split(strings.Repeat("same\n", maxDiffEditDistance*2), "\n")
+	lines, ok = getLineDiff(
+		append(append(append([]string{}, same...), "a"), same...),
+		append(append(append([]string{}, same...), "b"), same...),
+	)
+	a.TrueNow(ok)
+	a.EqualNow(len(lines), len(same)*2+2)
+	a.EqualNow(lines[len(same)].expectedLine, len(same)+1)
+	a.EqualNow(lines[len(lines)-1].actualLine, len(same)*2+1)
+
+	diff := formatTextDiff(strings.Join(expected, ""), strings.Join(actual, ""))
+	a.EqualNow(diff, "--- expected\n+++ actual\n(too many differences to show, first difference at line 1)")
+
+	diff = formatTextDiff(
+		strings.Join(expected[:maxDiffOutputLines], ""),
+		strings.Join(actual[:maxDiffOutputLines], ""),
+	)
+	a.EqualNow(strings.Count(diff, "\n"), maxDiffOutputLines)
+	a.HasSuffixStringNow(diff, fmt.Sprintf("\n... (%d more lines)", maxDiffOutputLines*2+3-maxDiffOutputLines))
+
+	err := New(new(testing.T)).Equal(strings.Join(actual, ""), strings.Join(expected, ""))
+	a.NotNilNow(err)
+	a.NotContainsStringNow(err.Error(), "--- expected")
+}
+
+func TestFormatTextDiff(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(formatTextDiff("a\nb", "a\nb"), "")
+	a.EqualNow(formatTextDiff("a\n", "b\n"), "--- expected\n+++ actual\n@@ -1 +1 @@\n1   -a\n  1 +b")
+	a.EqualNow(formatTextDiff("a\nb\n", "a\nb\nc\n"), `--- expected
++++ actual
+@@ -1,2 +1,3 @@
+1 1  a
+2 2  b
+  3 +c`)
+
+	expected := strings.Join([]string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", ""}, "\n")
+	actual := strings.Join([]string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", ""}, "\n")
+	a.EqualNow(formatTextDiff(expected, actual), `--- expected
++++ actual
+@@ -1,3 +1,4 @@
+  1 +0
+1 2  1
+2 3  2
+3 4  3
+@@ -7,4 +8,3 @@
+ 7  8  7
+ 8  9  8
+ 9 10  9
+10    -10`)
+}
+
+func TestFormatTextDiffInvisibleChanges(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(formatTextDiff("a\n\tb\nc \nd\r\ne\n", "a\n    b\nc\nd\ne"), `--- expected
++++ actual
+@@ -1,5 +1,5 @@
+1 1  a
+2   -→b
+3   -c·
+4   -d␍
+5   -e
+  2 +····b
+  3 +c
+  4 +d
+  5 +e
+\ No newline at end of file`)
+
+	// the whitespaces are not visualized if the lines have other changes
+	a.EqualNow(
+		formatTextDiff("\ta b\n", "\ta c\n"),
+		"--- expected\n+++ actual\n@@ -1 +1 @@\n1   -\ta b\n  1 +\ta c",
+	)
+}
+
+func TestSplitTextLines(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(splitTextLines(""), []string{})
+	a.EqualNow(splitTextLines("a"), []string{"a"})
+	a.EqualNow(splitTextLines("a\n"), []string{"a\n"})
+	a.EqualNow(splitTextLines("a\r\nb"), []string{"a\r\n", "b"})
+	a.EqualNow(splitTextLines("\n\n"), []string{"\n", "\n"})
+}
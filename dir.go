@@ -0,0 +1,373 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DirEqualOptions is the options of the directory tree comparison.
+type DirEqualOptions struct {
+	// Ignores are the glob patterns of the files and the directories to ignore. A file or a
+	// directory will be ignored if its slash-separated path relative to the root, or its base
+	// name matches any of the patterns, and all the files in an ignored directory are ignored.
+	Ignores []string
+	// IgnoreMode indicates whether to skip the comparison of the file modes.
+	IgnoreMode bool
+	// Update indicates whether to rewrite the expected directory with the actual directory
+	// instead of comparing them, and the expected directory must be a path in the update mode.
+	// The ignored files in the expected directory will be kept.
+	Update bool
+}
+
+// DirEqual tests whether the directory trees are the same, including the file paths, the file
+// modes, and the file contents. The directories can be the paths in the OS file system or the
+// `fs.FS` instances, and the empty directories are not compared. It'll set the result to fail
+// with the missing files, the extra files, the mode differences, and the content diffs if the
+// directory trees are not the same. It'll panic if any directory is not a path or an `fs.FS`.
+//
+//	a := assert.New(t)
+//	a.DirEqual(outputDir, "testdata/golden") // success
+//	a.DirEqual(os.DirFS(outputDir), "testdata/other") // fail
+func (a *Assertion) DirEqual(actual, expected any, message ...any) error {
+	a.Helper()
+
+	return tryDirEqual(a.testingT(), false, actual, expected, DirEqualOptions{}, message...)
+}
+
+// DirEqualNow tests whether the directory trees are the same, including the file paths, the file
+// modes, and the file contents. It'll terminate the execution if the directory trees are not the
+// same.
+//
+//	a := assert.New(t)
+//	a.DirEqualNow(outputDir, "testdata/golden") // success
+//	a.DirEqualNow(outputDir, "testdata/other") // fail and terminate
+//	// never runs
+func (a *Assertion) DirEqualNow(actual, expected any, message ...any) error {
+	a.Helper()
+
+	return tryDirEqual(a.testingT(), true, actual, expected, DirEqualOptions{}, message...)
+}
+
+// DirEqualWith tests whether the directory trees are the same with the options. It'll set the
+// result to fail with the differences if the directory trees are not the same. In the update
+// mode, it'll rewrite the expected directory with the actual directory and never fail.
+//
+//	var update = flag.Bool("update", false, "update golden files")
+//
+//	a := assert.New(t)
+//	a.DirEqualWith(outputDir, "testdata/golden", assert.DirEqualOptions{
+//	  Ignores: []string{"*.log"},
+//	  Update:  *update,
+//	}) // success
+func (a *Assertion) DirEqualWith(
+	actual, expected any,
+	options DirEqualOptions,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryDirEqual(a.testingT(), false, actual, expected, options, message...)
+}
+
+// DirEqualWithNow tests whether the directory trees are the same with the options. It'll terminate
+// the execution if the directory trees are not the same.
+//
+//	a := assert.New(t)
+//	a.DirEqualWithNow(outputDir, "testdata/golden", assert.DirEqualOptions{
+//	  IgnoreMode: true,
+//	}) // success
+//	a.DirEqualWithNow(outputDir, "testdata/other", assert.DirEqualOptions{}) // fail and terminate
+//	// never runs
+func (a *Assertion) DirEqualWithNow(
+	actual, expected any,
+	options DirEqualOptions,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryDirEqual(a.testingT(), true, actual, expected, options, message...)
+}
+
+// tryDirEqual tries to compare the directory trees, and it'll fail if the directory trees are not
+// the same. It'll rewrite the expected directory in the update mode.
+func tryDirEqual(
+	t testingT,
+	failedNow bool,
+	actual, expected any,
+	options DirEqualOptions,
+	message ...any,
+) error {
+	t.Helper()
+
+	actualFS := toDirFS(actual)
+	expectedFS := toDirFS(expected)
+
+	if options.Update {
+		expectedDir, ok := expected.(string)
+		if !ok {
+			panic(ErrNotWritableDir)
+		}
+
+		err := updateGoldenDir(actualFS, expectedDir, options)
+		return test(
+			t,
+			func() bool { return err == nil },
+			failedNow,
+			fmt.Sprintf(defaultErrMessageUpdateGoldenDir, expectedDir, err),
+			message...,
+		)
+	}
+
+	diff, err := getDirDiff(actualFS, expectedFS, options)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageDirEqual, diff)
+	if err != nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageReadDir, err)
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && diff == "" },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// toDirFS converts the path of the directory to the file system, and it'll panic if the value is
+// neither a path nor a file system.
+func toDirFS(dir any) fs.FS {
+	switch v := dir.(type) {
+	case string:
+		return os.DirFS(v)
+	case fs.FS:
+		return v
+	default:
+		panic(ErrNotDir)
+	}
+}
+
+// getDirDiff compares the directory trees, and returns the formatted differences. It returns an
+// empty string if the directory trees are the same.
+func getDirDiff(actual, expected fs.FS, options DirEqualOptions) (string, error) {
+	actualFiles, err := getDirFiles(actual, options.Ignores)
+	if err != nil {
+		return "", err
+	}
+	expectedFiles, err := getDirFiles(expected, options.Ignores)
+	if err != nil {
+		return "", err
+	}
+
+	missing := make([]string, 0)
+	extra := make([]string, 0)
+	modes := make([]string, 0)
+	contents := make([]string, 0)
+
+	for _, name := range getSortedKeys(expectedFiles) {
+		if _, ok := actualFiles[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	for _, name := range getSortedKeys(actualFiles) {
+		actualInfo := actualFiles[name]
+		expectedInfo, ok := expectedFiles[name]
+		if !ok {
+			extra = append(extra, name)
+			continue
+		}
+
+		if !options.IgnoreMode && actualInfo.Mode() != expectedInfo.Mode() {
+			modes = append(modes, fmt.Sprintf(
+				"%s: expect %v, got %v", name, expectedInfo.Mode(), actualInfo.Mode(),
+			))
+		}
+
+		actualContent, err := fs.ReadFile(actual, name)
+		if err != nil {
+			return "", err
+		}
+		expectedContent, err := fs.ReadFile(expected, name)
+		if err != nil {
+			return "", err
+		}
+		if string(actualContent) != string(expectedContent) {
+			contents = append(contents, name+":\n"+formatContentDiff(expectedContent, actualContent))
+		}
+	}
+
+	builder := strings.Builder{}
+	writeDirDiffSection(&builder, "missing files", missing)
+	writeDirDiffSection(&builder, "extra files", extra)
+	writeDirDiffSection(&builder, "mode differences", modes)
+	writeDirDiffSection(&builder, "content differences", contents)
+
+	return builder.String(), nil
+}
+
+// getDirFiles walks the file system, and returns the file info of all the files that are not
+// ignored by their slash-separated paths.
+func getDirFiles(fsys fs.FS, ignores []string) (map[string]fs.FileInfo, error) {
+	files := make(map[string]fs.FileInfo)
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if name == "." {
+			return nil
+		}
+
+		if isIgnoredPath(name, ignores) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files[name] = info
+
+		return nil
+	})
+
+	return files, err
+}
+
+// isIgnoredPath checks whether the path or its base name matches any of the glob patterns. It'll
+// panic if any pattern is malformed.
+func isIgnoredPath(name string, ignores []string) bool {
+	for _, pattern := range ignores {
+		for _, s := range []string{name, path.Base(name)} {
+			ok, err := path.Match(pattern, s)
+			if err != nil {
+				panic(err)
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// writeDirDiffSection writes the section of the differences with the title to the builder, and
+// nothing will be written if there are no differences.
+func writeDirDiffSection(builder *strings.Builder, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+
+	if builder.Len() > 0 {
+		builder.WriteString("\n\n")
+	}
+	builder.WriteString(title + ":")
+
+	for _, item := range items {
+		builder.WriteString("\n  " + strings.ReplaceAll(item, "\n", "\n  "))
+	}
+}
+
+// updateGoldenDir rewrites the files in the expected directory with the files in the actual
+// directory, and removes the files that are not in the actual directory except the ignored ones.
+func updateGoldenDir(actual fs.FS, expectedDir string, options DirEqualOptions) error {
+	expectedDir = filepath.Clean(expectedDir)
+	actualFiles, err := getDirFiles(actual, options.Ignores)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(expectedDir, 0755); err != nil {
+		return err
+	}
+	expectedFiles, err := getDirFiles(os.DirFS(expectedDir), options.Ignores)
+	if err != nil {
+		return err
+	}
+
+	for name := range expectedFiles {
+		if _, ok := actualFiles[name]; !ok {
+			target := filepath.Join(expectedDir, filepath.FromSlash(name))
+			if err := os.Remove(target); err != nil {
+				return err
+			}
+			if err := removeEmptyDirs(filepath.Dir(target), expectedDir); err != nil {
+				return err
+			}
+		}
+	}
+
+	for name, info := range actualFiles {
+		content, err := fs.ReadFile(actual, name)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(expectedDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		// remove the golden file first, it may be read-only if the actual file is read-only.
+		if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		if err := os.WriteFile(target, content, info.Mode().Perm()); err != nil {
+			return err
+		}
+		if err := os.Chmod(target, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeEmptyDirs removes the directory and its parent directories if they are empty, and it
+// stops at the root directory that will never be removed, or any directory that is not in the
+// root directory.
+func removeEmptyDirs(dir, root string) error {
+	for {
+		rel, err := filepath.Rel(root, dir)
+		if err != nil || rel == "." || rel == ".." ||
+			strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return nil
+		}
+
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return err
+		} else if len(entries) > 0 {
+			return nil
+		}
+
+		if err := os.Remove(dir); err != nil {
+			return err
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// getSortedKeys returns the keys of the map in the ascending order.
+func getSortedKeys(m map[string]fs.FileInfo) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
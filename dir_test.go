@@ -0,0 +1,286 @@
+package assert
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func newTestGoldenFS() fstest.MapFS {
+	return fstest.MapFS{
+		"a.txt":       {Data: []byte("a\n"), Mode: 0644},
+		"b/b.txt":     {Data: []byte("b1\nb2\nb3\n"), Mode: 0644},
+		"b/c/run.sh":  {Data: []byte("#!/bin/sh\n"), Mode: 0755},
+		"build/x.log": {Data: []byte("log\n"), Mode: 0644},
+	}
+}
+
+func TestDirEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	golden := newTestGoldenFS()
+
+	testDirEqual(a, mockA, newTestGoldenFS(), golden, true)
+	testDirEqual(a, mockA, fstest.MapFS{}, fstest.MapFS{}, true)
+	testDirEqual(a, mockA, fstest.MapFS{"empty": {Mode: 0755 | os.ModeDir}}, fstest.MapFS{}, true)
+
+	actual := newTestGoldenFS()
+	delete(actual, "a.txt")
+	testDirEqual(a, mockA, actual, golden, false)
+
+	actual = newTestGoldenFS()
+	actual["d.txt"] = &fstest.MapFile{Data: []byte("d\n")}
+	testDirEqual(a, mockA, actual, golden, false)
+
+	actual = newTestGoldenFS()
+	actual["b/c/run.sh"].Mode = 0644
+	testDirEqual(a, mockA, actual, golden, false)
+
+	actual = newTestGoldenFS()
+	actual["b/b.txt"].Data = []byte("b1\nb3\n")
+	testDirEqual(a, mockA, actual, golden, false)
+
+	a.PanicNow(func() {
+		mockA.DirEqual(1, golden)
+	})
+	a.PanicNow(func() {
+		mockA.DirEqual(golden, nil)
+	})
+}
+
+func testDirEqual(a, mockA *Assertion, actual, expected any, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "DirEqual", func() error {
+		return DirEqual(mockA.T, actual, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.DirEqual", func() error {
+		return mockA.DirEqual(actual, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "DirEqualNow", func() {
+		DirEqualNow(mockA.T, actual, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.DirEqualNow", func() {
+		mockA.DirEqualNow(actual, expected)
+	}, !isOk)
+}
+
+func TestDirEqualMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	actual := newTestGoldenFS()
+	delete(actual, "a.txt")
+	actual["d.txt"] = &fstest.MapFile{Data: []byte("d\n"), Mode: 0644}
+	actual["b/c/run.sh"].Mode = 0644
+	actual["b/b.txt"].Data = []byte("b1\nb3\n")
+
+	err := mockA.DirEqual(actual, newTestGoldenFS())
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect directory trees equal:
+
+missing files:
+  a.txt
+
+extra files:
+  d.txt
+
+mode differences:
+  b/c/run.sh: expect -rwxr-xr-x, got -rw-r--r--
+
+content differences:
+  b/b.txt:
+  --- expected
+  +++ actual
+  @@ -1,3 +1,2 @@
+  1 1  b1
+  2   -b2
+  3 2  b3`)
+}
+
+func TestDirEqualWith(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	golden := newTestGoldenFS()
+
+	actual := newTestGoldenFS()
+	delete(actual, "build/x.log")
+	actual["c.log"] = &fstest.MapFile{Data: []byte("log\n")}
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{}, false)
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{
+		Ignores: []string{"build", "*.log"},
+	}, true)
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{
+		Ignores: []string{"build/*", "c.log"},
+	}, true)
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{
+		Ignores: []string{"c.log"},
+	}, false)
+
+	actual = newTestGoldenFS()
+	actual["b/c/run.sh"].Mode = 0644
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{}, false)
+	testDirEqualWith(a, mockA, actual, golden, DirEqualOptions{IgnoreMode: true}, true)
+
+	a.PanicNow(func() {
+		mockA.DirEqualWith(actual, golden, DirEqualOptions{Ignores: []string{"["}})
+	})
+	a.PanicNow(func() {
+		mockA.DirEqualWith(actual, golden, DirEqualOptions{Update: true})
+	})
+}
+
+func testDirEqualWith(
+	a, mockA *Assertion,
+	actual, expected any,
+	options DirEqualOptions,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "DirEqualWith", func() error {
+		return DirEqualWith(mockA.T, actual, expected, options)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.DirEqualWith", func() error {
+		return mockA.DirEqualWith(actual, expected, options)
+	}, isOk)
+	testAssertionNowFunction(a, "DirEqualWithNow", func() {
+		DirEqualWithNow(mockA.T, actual, expected, options)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.DirEqualWithNow", func() {
+		mockA.DirEqualWithNow(actual, expected, options)
+	}, !isOk)
+}
+
+func TestDirEqualWithOSPath(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	dir := t.TempDir()
+	a.NilNow(os.MkdirAll(filepath.Join(dir, "b"), 0755))
+	a.NilNow(os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a\n"), 0644))
+	a.NilNow(os.WriteFile(filepath.Join(dir, "b", "b.txt"), []byte("b\n"), 0644))
+
+	expected := fstest.MapFS{
+		"a.txt":   {Data: []byte("a\n")},
+		"b/b.txt": {Data: []byte("b\n")},
+	}
+	options := DirEqualOptions{IgnoreMode: true}
+
+	testDirEqualWith(a, mockA, dir, expected, options, true)
+	testDirEqualWith(a, mockA, dir, os.DirFS(dir), DirEqualOptions{}, true)
+	testDirEqualWith(a, mockA, filepath.Join(dir, "b"), expected, options, false)
+
+	err := mockA.DirEqual(filepath.Join(dir, "not-exist"), expected)
+	a.NotNilNow(err)
+	a.TrueNow(strings.HasPrefix(
+		err.Error(),
+		"assert error: expect directories readable, got error: ",
+	))
+}
+
+func TestDirEqualUpdate(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+	mockA := New(mockT)
+
+	golden := filepath.Join(t.TempDir(), "golden")
+	a.NilNow(os.MkdirAll(filepath.Join(golden, "old"), 0755))
+	a.NilNow(os.WriteFile(filepath.Join(golden, "old", "old.txt"), []byte("old\n"), 0644))
+	a.NilNow(os.WriteFile(filepath.Join(golden, "keep.log"), []byte("keep\n"), 0644))
+	a.NilNow(os.WriteFile(filepath.Join(golden, "a.txt"), []byte("old a\n"), 0644))
+
+	actual := newTestGoldenFS()
+	// Windows reports the permission bits of the files as 0666 or 0777.
+	ignoreMode := runtime.GOOS == "windows"
+	options := DirEqualOptions{Ignores: []string{"*.log"}, IgnoreMode: ignoreMode, Update: true}
+
+	a.NilNow(mockA.DirEqualWith(actual, golden, options))
+	a.NotTrueNow(mockT.Failed())
+
+	options.Update = false
+	a.NilNow(mockA.DirEqualWith(actual, golden, options))
+	a.NotTrueNow(mockT.Failed())
+
+	a.NoFileExistsNow(nil, filepath.Join(golden, "old", "old.txt"))
+	_, err := os.Stat(filepath.Join(golden, "old"))
+	a.TrueNow(os.IsNotExist(err))
+	a.FileContentEqualNow(nil, filepath.Join(golden, "keep.log"), "keep\n")
+	a.FileContentEqualNow(nil, filepath.Join(golden, "a.txt"), "a\n")
+	if !ignoreMode {
+		a.FileModeNow(nil, filepath.Join(golden, "b", "c", "run.sh"), 0755)
+	}
+	a.NoFileExistsNow(nil, filepath.Join(golden, "build", "x.log"))
+
+	// creates the golden directory if it does not exist
+	golden = filepath.Join(t.TempDir(), "new")
+	a.NilNow(mockA.DirEqualWith(actual, golden, DirEqualOptions{IgnoreMode: ignoreMode, Update: true}))
+	a.NilNow(mockA.DirEqualWith(actual, golden, DirEqualOptions{IgnoreMode: ignoreMode}))
+}
+
+func TestDirEqualUpdateUncleanPath(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+	mockA := New(mockT)
+
+	golden := filepath.Join(t.TempDir(), "golden")
+	a.NilNow(os.MkdirAll(filepath.Join(golden, "old", "sub"), 0755))
+	a.NilNow(os.WriteFile(filepath.Join(golden, "old", "sub", "old.txt"), []byte("old\n"), 0644))
+
+	actual := fstest.MapFS{"a.txt": {Data: []byte("a\n"), Mode: 0644}}
+	options := DirEqualOptions{IgnoreMode: true, Update: true}
+	sep := string(filepath.Separator)
+
+	a.NilNow(mockA.DirEqualWith(actual, golden+sep+"."+sep, options))
+	a.NotTrueNow(mockT.Failed())
+
+	_, err := os.Stat(filepath.Join(golden, "old"))
+	a.TrueNow(os.IsNotExist(err))
+	a.DirExistsNow(nil, golden)
+	a.FileContentEqualNow(nil, filepath.Join(golden, "a.txt"), "a\n")
+}
+
+func TestRemoveEmptyDirs(t *testing.T) {
+	a := New(t)
+
+	dir := t.TempDir()
+	root := filepath.Join(dir, "golden")
+	a.NilNow(os.MkdirAll(filepath.Join(root, "a", "b"), 0755))
+	a.NilNow(os.MkdirAll(filepath.Join(dir, "golden2", "c"), 0755))
+
+	a.NilNow(removeEmptyDirs(filepath.Join(root, "a", "b"), root))
+	a.DirExistsNow(nil, root)
+	_, err := os.Stat(filepath.Join(root, "a"))
+	a.TrueNow(os.IsNotExist(err))
+
+	// never removes the directories that are not in the root directory
+	a.NilNow(removeEmptyDirs(filepath.Join(dir, "golden2", "c"), root))
+	a.DirExistsNow(nil, filepath.Join(dir, "golden2", "c"))
+}
+
+func TestDirEqualUpdateReadOnlyFiles(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+	mockA := New(mockT)
+
+	golden := filepath.Join(t.TempDir(), "golden")
+	actual := fstest.MapFS{
+		"ro.txt": {Data: []byte("v1\n"), Mode: 0444},
+	}
+	options := DirEqualOptions{Update: true}
+
+	a.NilNow(mockA.DirEqualWith(actual, golden, options))
+	a.FileModeNow(nil, filepath.Join(golden, "ro.txt"), 0444)
+
+	// updates the existing read-only golden file
+	actual["ro.txt"] = &fstest.MapFile{Data: []byte("v2\n"), Mode: 0444}
+	a.NilNow(mockA.DirEqualWith(actual, golden, options))
+	a.NotTrueNow(mockT.Failed())
+	a.FileContentEqualNow(nil, filepath.Join(golden, "ro.txt"), "v2\n")
+	a.FileModeNow(nil, filepath.Join(golden, "ro.txt"), 0444)
+}
@@ -16,14 +16,21 @@ const (
 	defaultErrMessageNotHasPrefixString string = "expect has no prefix \"%s\""
 	defaultErrMessageHasSuffixString    string = "expect has suffix \"%s\""
 	defaultErrMessageNotHasSuffixString string = "expect has no suffix \"%s\""
-	defaultErrMessageMatch              string = "the input did not match the regular expression"
-	defaultErrMessageNotMatch           string = "the input match the regular expression"
+	defaultErrMessageMatch              string = "expect %q matches pattern `%s`"
+	defaultErrMessageNotMatch           string = "expect %q does not match pattern `%s`"
 	defaultErrMessageNil                string = "expect nil, got %v"
 	defaultErrMessageNotNil             string = "expect not nil, got nil"
 	defaultErrMessagePanic              string = "missing expected panic"
 	defaultErrMessageNotPanic           string = "got unwanted error: %v"
 	defaultErrMessagePanicOf            string = "expect panic by %v, got %v"
 	defaultErrMessageNotPanicOf         string = "got unexpected panic error: %v"
+	defaultErrMessagePanicIs            string = "expect panic matches %v, got %s"
+	defaultErrMessagePanicAs            string = "expect panic as %s, got %s"
+	defaultErrMessagePanicMatch         string = "expect panic matches pattern `%s`, got %s"
+	defaultErrMessagePanicContains      string = "expect panic contains \"%s\", got %s"
+	defaultErrMessagePanicWith          string = "expect panic satisfies the predicate, got %s"
+	defaultErrMessagePanicRuntimeError  string = "expect panic by a runtime error, got %s"
+	defaultErrMessagePanicRuntimeKind   string = "expect panic by a runtime error of %s, got %s"
 	defaultErrMessageTrue               string = "the expression evaluated to a falsy value"
 	defaultErrMessageNotTrue            string = "the expression evaluated to a truthy value"
 	defaultErrMessageMapHasKey          string = "expect map has key %v"
@@ -34,21 +41,136 @@ const (
 	defaultErrMessageGte                string = "%v must greater than or equal to %v"
 	defaultErrMessageLt                 string = "%v must less then %v"
 	defaultErrMessageLte                string = "%v must less then or equal to %v"
-	defaultErrMessageIsError            string = "expect err matches %v, got %v"
+	defaultErrMessageIsError            string = "expect err matches %v, got %s"
 	defaultErrMessageNotIsError         string = "expect err does not matches %v"
+	defaultErrMessageAllMatch           string = "expect all elements match the predicate, mismatched at %v"
+	defaultErrMessageAnyMatch           string = "expect any element matches the predicate"
+	defaultErrMessageNoneMatch          string = "expect no element matches the predicate, matched at %v"
+	defaultErrMessageCountMatch         string = "expect %d elements match the predicate, got %d (matched at %v)"
+	defaultErrMessageMismatchReason     string = "\nmismatched at %v: %s"
+	defaultErrMessageBetween            string = "%v not in [%v, %v]"
+	defaultErrMessageBetweenExclusive   string = "%v not in (%v, %v)"
+	defaultErrMessageInDelta            string = "the difference between %v and %v is %v, more than %v"
+	defaultErrMessageInEpsilonPercent   string = "the difference between %v and %v is %.4g%%, more than %v%%"
+	defaultErrMessageFloatEqualWith     string = "%v != %v with tolerance %+v"
+	defaultErrMessageFloatNotEqualWith  string = "%v == %v with tolerance %+v"
+	defaultErrMessageFloatsEqual        string = "%v != %v at index %s"
+	defaultErrMessageFloatsLength       string = "the lengths are not equal%s: %d != %d"
+	defaultErrMessageTimeEqual          string = "%s != %s, the difference is %v"
+	defaultErrMessageWithinDuration     string = "expect %s within %v of %s, the difference is %v"
+	defaultErrMessageBefore             string = "expect %s before %s, the difference is %v"
+	defaultErrMessageAfter              string = "expect %s after %s, the difference is %v"
+	defaultErrMessageSameDay            string = "expect %s in the same day as %s"
+	defaultErrMessageTimeInLocation     string = "expect %s in location %v, got %v"
+	defaultErrMessageDurationWithin     string = "expect %v within %v of %v, the difference is %v"
+	defaultErrMessageNoError            string = "expect no error, got %s"
+	defaultErrMessageHasError           string = "expect an error, got nil"
+	defaultErrMessageErrorAs            string = "expect err as %s, got %s"
+	defaultErrMessageErrorContains      string = "expect err contains \"%s\", got %s"
+	defaultErrMessageErrorMatches       string = "expect err matches pattern `%s`, got %s"
+	defaultErrMessageErrorType          string = "expect err of type %T, got %s"
+	defaultErrMessageErrorEqualMessage  string = "expect err message \"%s\", got %s"
+	defaultErrMessageErrorChainEqual    string = "expect err chain %s, got %s"
+	defaultErrMessageGoroutineLeak      string = "found %d leaked goroutine(s):\n\n%s"
+	defaultErrMessageCompletesWithin    string = "expect function completes within %v, goroutine stacks:\n\n%s"
+	defaultErrMessageBlocks             string = "expect function blocks for %v, it returned after %v"
+	defaultErrMessageUnexpectedPanic    string = "got unexpected panic: %s\n\n%s"
+	defaultErrMessageReceives           string = "expect receive a value within %v"
+	defaultErrMessageReceivesValue      string = "expect receive %s, got %s"
+	defaultErrMessageChannelClosed      string = "expect receive a value, but the channel is closed"
+	defaultErrMessageNotReceives        string = "expect no value received within %v, got %s"
+	defaultErrMessageClosed             string = "expect channel closed"
+	defaultErrMessageNotClosed          string = "expect channel not closed"
+	defaultErrMessageChannelLen         string = "expect channel length %d, got %d"
+	defaultErrMessageChannelYields      string = "expect channel yields %v, got %v"
+	defaultErrMessageChannelNotClosed   string = "expect channel closed within %v, received %v"
+	defaultErrMessageHTTPStatus         string = "expect status code %d, got %d\n\n%s"
+	defaultErrMessageHTTPHeader         string = "expect header %s: %s, got %q\n\n%s"
+	defaultErrMessageHTTPBodyContains   string = "expect body contains \"%s\"\n\n%s"
+	defaultErrMessageHTTPBodyJSONEqual  string = "expect body equals JSON %s\n\n%s"
+	defaultErrMessageHTTPRedirectsTo    string = "expect redirect to %s, got %d %q\n\n%s"
+	defaultErrMessageReceivedRequest    string = "expect request %s %s, received requests:\n%s"
+	defaultErrMessageRequestCount       string = "expect %d request(s) of %s %s, got %d"
+	defaultErrMessageRequestHeader      string = "expect request %s %s with header %s: %s, got %q"
+	defaultErrMessageRequestBodyJSON    string = "expect request %s %s with JSON body %s, got %q"
+	defaultErrMessageUnexpectedRequests string = "got %d unexpected request(s):\n%s"
+	defaultErrMessageFileError          string = "expect file \"%s\" accessible, got error: %v"
+	defaultErrMessageFileExists         string = "expect file \"%s\" exists, %s"
+	defaultErrMessageNoFileExists       string = "expect file \"%s\" not exists"
+	defaultErrMessageDirExists          string = "expect directory \"%s\" exists, %s"
+	defaultErrMessageFileMode           string = "expect file \"%s\" mode %v, got %v"
+	defaultErrMessageFileContentEqual   string = "expect file \"%s\" content equals:\n\n%s"
+	defaultErrMessageFileContains       string = "expect file \"%s\" contains \"%s\""
+	defaultErrMessageFileMatches        string = "expect file \"%s\" matches pattern `%s`"
+	defaultErrMessageFileSize           string = "expect file \"%s\" size %d, got %d"
+	defaultErrMessageDirEqual           string = "expect directory trees equal:\n\n%s"
+	defaultErrMessageReadDir            string = "expect directories readable, got error: %v"
+	defaultErrMessageUpdateGoldenDir    string = "expect directory \"%s\" updated, got error: %v"
+	defaultErrMessageReaderEqual        string = "expect readers equal, first difference at offset %d (line %d, column %d):\n\n%s"
+	defaultErrMessageReadReader         string = "expect readers readable, got error: %v"
+	defaultErrMessageTextEqual          string = "expect texts equal:\n\n%s"
+	defaultErrMessageTextNotEqual       string = "expect texts not equal, got:\n\n%s"
+	defaultErrMessageEqualFold          string = "expect strings equal ignoring case, compared:\n\n%s"
+	defaultErrMessageEqualIgnoreSpace   string = "expect strings equal ignoring whitespaces, compared:\n\n%s"
+	defaultErrMessageEqualNewlines      string = "expect strings equal ignoring line terminators, compared:\n\n%s"
+	defaultErrMessageEqualIgnoreIndent  string = "expect strings equal ignoring indentation, compared:\n\n%s"
+	defaultErrMessageContainsFold       string = "expect contains \"%s\" ignoring case, got \"%s\""
+	defaultErrMessageHasPrefixFold      string = "expect has prefix \"%s\" ignoring case, got \"%s\""
+	defaultErrMessageHasSuffixFold      string = "expect has suffix \"%s\" ignoring case, got \"%s\""
+	defaultErrMessageContainsAllStrings string = "expect contains all of %q, missing %q"
+	defaultErrMessageContainsAnyString  string = "expect contains any of %q"
+	defaultErrMessageContainsNoneString string = "expect contains none of %q, found %s"
+	defaultErrMessageStringCount        string = "expect %d occurrence(s) of \"%s\", got %d"
+	defaultErrMessageContainsInOrder    string = "expect contains %q in order, %s"
+	defaultErrMessageFullMatch          string = "expect %q fully matches pattern `%s`"
+	defaultErrMessageMatchCount         string = "expect %d match(es) of pattern `%s` in %q, got %d"
+	defaultErrMessageMatchGroups        string = "expect capture groups of %q by pattern `%s` equal:\n%s"
+	defaultErrMessageBytesEqual         string = "expect bytes equal, first difference at offset %d (expected %d bytes, got %d bytes):\n\n%s"
+	defaultErrMessageBytesHasPrefix     string = "expect bytes have prefix, first difference at offset %d:\n\n%s"
+	defaultErrMessageBytesContains      string = "expect bytes contain:\n%s\ngot:\n%s"
+	defaultErrMessageBytesLen           string = "expect %d bytes, got %d"
+	defaultErrMessageIsType             string = "expect type %s, got %s"
+	defaultErrMessageIsKind             string = "expect kind %s, got %s (type %s)"
+	defaultErrMessageImplements         string = "expect type %s implements %s, missing methods: %s"
+	defaultErrMessageAssignableTo       string = "expect type %s assignable to %s"
+	defaultErrMessageConvertibleTo      string = "expect type %s convertible to %s"
+	defaultErrMessageZero               string = "expect zero value, got %v (%T)"
+	defaultErrMessageNotZero            string = "expect non-zero value, got zero value of %T"
+	defaultErrMessageSame               string = "expect same object, expected %s, got %s"
+	defaultErrMessageNotSame            string = "expect different objects, got %s for both"
 )
 
 var (
+	// ErrInvalidPredicate indicates that the predicate must be a function like `func(T) bool` or
+	// `func(*Assertion, T)` that accepts the elements.
+	ErrInvalidPredicate error = errors.New("the predicate must be a func(T) bool or a func(*Assertion, T)")
 	// ErrNotArray indicates that the value must be a slice or an array.
 	ErrNotArray error = errors.New("the value must be a slice or an array")
+	// ErrNotChannel indicates that the value must be a channel that can receive values.
+	ErrNotChannel error = errors.New("the value must be a receivable channel")
+	// ErrNotCollection indicates that the value must be a slice, an array, or a map.
+	ErrNotCollection error = errors.New("the value must be a slice, an array, or a map")
+	// ErrNotDir indicates that the value must be a path of a directory or an `fs.FS`.
+	ErrNotDir error = errors.New("the value must be a directory path or an fs.FS")
 	// ErrNotFloat indicates that the value must be a floating number.
 	ErrNotFloat error = errors.New("the value must be a floating number")
+	// ErrNotInterface indicates that the value must be a nil pointer to an interface.
+	ErrNotInterface error = errors.New("the value must be a pointer to an interface")
 	// ErrNotMap indicates that the value must be a map.
 	ErrNotMap error = errors.New("the value must be a map")
+	// ErrNotNumber indicates that the value must be an integer or a floating number.
+	ErrNotNumber error = errors.New("the value must be a number")
 	// ErrNotOrderable indicates that the value must be orderable.
 	ErrNotOrderable error = errors.New("the value must be orderable")
+	// ErrNotReference indicates that the value must be a pointer, a map, a slice, a channel, a
+	// function, or the untyped nil.
+	ErrNotReference error = errors.New(
+		"the value must be a pointer, a map, a slice, a channel, or a function",
+	)
 	// ErrNotSameType indicates that both values must be the same type.
 	ErrNotSameType error = errors.New("the values must be the same type")
+	// ErrNotWritableDir indicates that the directory must be a path in the OS file system to write.
+	ErrNotWritableDir error = errors.New("the directory must be a path to write")
 	// ErrRequireT indicates that the instance of testing.T is a required parameter.
 	ErrRequireT error = errors.New("testing.T is required")
 )
@@ -3,7 +3,9 @@ package assert
 import (
 	"errors"
 	"fmt"
-	"testing"
+	"reflect"
+	"regexp"
+	"strings"
 )
 
 // IsError tests whether the error matches the target or not. It'll set the result to fail if the
@@ -17,7 +19,7 @@ import (
 //	a.IsError(errors.Join(err1, err2), err1) // success
 //	a.IsError(errors.Join(err1, err2), err2) // success
 func (a *Assertion) IsError(err, expected error, message ...any) error {
-	return isError(a.T, false, err, expected, message...)
+	return isError(a.testingT(), false, err, expected, message...)
 }
 
 // IsErrorNow tests whether the error matches the target or not. It'll set the result to fail and
@@ -32,7 +34,7 @@ func (a *Assertion) IsError(err, expected error, message ...any) error {
 //	a.IsErrorNow(err1, err2) // fail
 //	// never runs
 func (a *Assertion) IsErrorNow(err, expected error, message ...any) error {
-	return isError(a.T, true, err, expected, message...)
+	return isError(a.testingT(), true, err, expected, message...)
 }
 
 // NotIsError tests whether the error matches the target or not. It'll set the result to fail if
@@ -48,7 +50,7 @@ func (a *Assertion) IsErrorNow(err, expected error, message ...any) error {
 //	a.NotIsError(errors.Join(err1, err2), err1) // fail
 //	a.NotIsError(errors.Join(err1, err2), err2) // fail
 func (a *Assertion) NotIsError(err, unexpected error, message ...any) error {
-	return notIsError(a.T, false, err, unexpected, message...)
+	return notIsError(a.testingT(), false, err, unexpected, message...)
 }
 
 // NotIsErrorNow tests whether the error matches the target or not. It'll set the result to fail
@@ -63,24 +65,24 @@ func (a *Assertion) NotIsError(err, unexpected error, message ...any) error {
 //	a.NotIsErrorNow(err1, err1) // fail and terminate
 //	// never runs
 func (a *Assertion) NotIsErrorNow(err, unexpected error, message ...any) error {
-	return notIsError(a.T, true, err, unexpected, message...)
+	return notIsError(a.testingT(), true, err, unexpected, message...)
 }
 
 // isError tests whether the error matches the target or not.
-func isError(t *testing.T, failedNow bool, err, expected error, message ...any) error {
+func isError(t testingT, failedNow bool, err, expected error, message ...any) error {
 	t.Helper()
 
 	return test(
 		t,
 		func() bool { return errors.Is(err, expected) },
 		failedNow,
-		fmt.Sprintf(defaultErrMessageIsError, expected, err),
+		fmt.Sprintf(defaultErrMessageIsError, expected, formatErrorTree(err)),
 		message...,
 	)
 }
 
 // isError tests whether the error does not match the target error or not.
-func notIsError(t *testing.T, failedNow bool, err, unexpected error, message ...any) error {
+func notIsError(t testingT, failedNow bool, err, unexpected error, message ...any) error {
 	return test(
 		t,
 		func() bool { return !errors.Is(err, unexpected) },
@@ -89,3 +91,499 @@ func notIsError(t *testing.T, failedNow bool, err, unexpected error, message ...
 		message...,
 	)
 }
+
+// NoError tests whether the error is nil or not, and it set the result to fail if the error is not
+// nil. The message will contain the whole chain of the wrapped errors.
+//
+//	a := assert.New(t)
+//	a.NoError(nil) // success
+//	a.NoError(errors.New("some error")) // fail
+func (a *Assertion) NoError(err error, message ...any) error {
+	a.Helper()
+
+	return tryNoError(a.testingT(), false, err, message...)
+}
+
+// NoErrorNow tests whether the error is nil or not, and it will terminate the execution if the
+// error is not nil.
+//
+//	a := assert.New(t)
+//	a.NoErrorNow(nil) // success
+//	a.NoErrorNow(errors.New("some error")) // fail and terminate
+//	// never runs
+func (a *Assertion) NoErrorNow(err error, message ...any) error {
+	a.Helper()
+
+	return tryNoError(a.testingT(), true, err, message...)
+}
+
+// HasError tests whether the error is nil or not, and it set the result to fail if the error is
+// nil.
+//
+//	a := assert.New(t)
+//	a.HasError(errors.New("some error")) // success
+//	a.HasError(nil) // fail
+func (a *Assertion) HasError(err error, message ...any) error {
+	a.Helper()
+
+	return tryHasError(a.testingT(), false, err, message...)
+}
+
+// HasErrorNow tests whether the error is nil or not, and it will terminate the execution if the
+// error is nil.
+//
+//	a := assert.New(t)
+//	a.HasErrorNow(errors.New("some error")) // success
+//	a.HasErrorNow(nil) // fail and terminate
+//	// never runs
+func (a *Assertion) HasErrorNow(err error, message ...any) error {
+	a.Helper()
+
+	return tryHasError(a.testingT(), true, err, message...)
+}
+
+// ErrorAs tests whether any error in the chain of the error matches the target, and sets the
+// target to the matched error like `errors.As`. It set the result to fail if no error in the
+// chain matches the target, and it'll panic if the target is not a non-nil pointer to either a
+// type that implements error, or to any interface type.
+//
+//	a := assert.New(t)
+//	var pathErr *fs.PathError
+//	_, err := os.Open("not-exist-file")
+//	a.ErrorAs(err, &pathErr) // success
+//	a.ErrorAs(errors.New("some error"), &pathErr) // fail
+func (a *Assertion) ErrorAs(err error, target any, message ...any) error {
+	a.Helper()
+
+	return tryErrorAs(a.testingT(), false, err, target, message...)
+}
+
+// ErrorAsNow tests whether any error in the chain of the error matches the target, and sets the
+// target to the matched error like `errors.As`. It will terminate the execution if no error in the
+// chain matches the target.
+//
+//	a := assert.New(t)
+//	var pathErr *fs.PathError
+//	_, err := os.Open("not-exist-file")
+//	a.ErrorAsNow(err, &pathErr) // success
+//	a.ErrorAsNow(errors.New("some error"), &pathErr) // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorAsNow(err error, target any, message ...any) error {
+	a.Helper()
+
+	return tryErrorAs(a.testingT(), true, err, target, message...)
+}
+
+// ErrorContains tests whether the message of the error contains the substring, and it set the
+// result to fail if the error is nil or its message does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.ErrorContains(errors.New("some error"), "some") // success
+//	a.ErrorContains(errors.New("some error"), "other") // fail
+//	a.ErrorContains(nil, "some") // fail
+func (a *Assertion) ErrorContains(err error, substr string, message ...any) error {
+	a.Helper()
+
+	return tryErrorContains(a.testingT(), false, err, substr, message...)
+}
+
+// ErrorContainsNow tests whether the message of the error contains the substring, and it will
+// terminate the execution if the error is nil or its message does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.ErrorContainsNow(errors.New("some error"), "some") // success
+//	a.ErrorContainsNow(errors.New("some error"), "other") // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorContainsNow(err error, substr string, message ...any) error {
+	a.Helper()
+
+	return tryErrorContains(a.testingT(), true, err, substr, message...)
+}
+
+// ErrorMatches tests whether the message of the error matches the regular expression pattern, and
+// it set the result to fail if the error is nil or its message does not match the pattern. It'll
+// panic if the pattern is not a valid regular expression.
+//
+//	a := assert.New(t)
+//	a.ErrorMatches(errors.New("error code 404"), `code \d+`) // success
+//	a.ErrorMatches(errors.New("error code 404"), `^code`) // fail
+func (a *Assertion) ErrorMatches(err error, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryErrorMatches(a.testingT(), false, err, pattern, message...)
+}
+
+// ErrorMatchesNow tests whether the message of the error matches the regular expression pattern,
+// and it will terminate the execution if the error is nil or its message does not match the
+// pattern. It'll panic if the pattern is not a valid regular expression.
+//
+//	a := assert.New(t)
+//	a.ErrorMatchesNow(errors.New("error code 404"), `code \d+`) // success
+//	a.ErrorMatchesNow(errors.New("error code 404"), `^code`) // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorMatchesNow(err error, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryErrorMatches(a.testingT(), true, err, pattern, message...)
+}
+
+// ErrorType tests whether any error in the chain of the error has the same type as the expected
+// error, and it set the result to fail if no error in the chain has the same type.
+//
+//	a := assert.New(t)
+//	_, err := os.Open("not-exist-file")
+//	a.ErrorType(err, &fs.PathError{}) // success
+//	a.ErrorType(errors.New("some error"), &fs.PathError{}) // fail
+func (a *Assertion) ErrorType(err, expected error, message ...any) error {
+	a.Helper()
+
+	return tryErrorType(a.testingT(), false, err, expected, message...)
+}
+
+// ErrorTypeNow tests whether any error in the chain of the error has the same type as the
+// expected error, and it will terminate the execution if no error in the chain has the same type.
+//
+//	a := assert.New(t)
+//	_, err := os.Open("not-exist-file")
+//	a.ErrorTypeNow(err, &fs.PathError{}) // success
+//	a.ErrorTypeNow(errors.New("some error"), &fs.PathError{}) // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorTypeNow(err, expected error, message ...any) error {
+	a.Helper()
+
+	return tryErrorType(a.testingT(), true, err, expected, message...)
+}
+
+// ErrorEqualMessage tests whether the message of the error equals to the expected message, and it
+// set the result to fail if the error is nil or its message is not the expected message.
+//
+//	a := assert.New(t)
+//	a.ErrorEqualMessage(errors.New("some error"), "some error") // success
+//	a.ErrorEqualMessage(errors.New("some error"), "other error") // fail
+func (a *Assertion) ErrorEqualMessage(err error, expected string, message ...any) error {
+	a.Helper()
+
+	return tryErrorEqualMessage(a.testingT(), false, err, expected, message...)
+}
+
+// ErrorEqualMessageNow tests whether the message of the error equals to the expected message, and
+// it will terminate the execution if the error is nil or its message is not the expected message.
+//
+//	a := assert.New(t)
+//	a.ErrorEqualMessageNow(errors.New("some error"), "some error") // success
+//	a.ErrorEqualMessageNow(errors.New("some error"), "other error") // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorEqualMessageNow(err error, expected string, message ...any) error {
+	a.Helper()
+
+	return tryErrorEqualMessage(a.testingT(), true, err, expected, message...)
+}
+
+// ErrorChainEqual tests whether the sentinel errors that wrapped by the error are the expected
+// errors in order, and it set the result to fail if any expected error is missing, any other
+// sentinel error is wrapped, or they are in a different order. The sentinel errors are the errors
+// in the tree of the error that do not wrap other errors, and they are visited in the depth-first
+// order.
+//
+//	a := assert.New(t)
+//	ErrNotFound := errors.New("not found")
+//	ErrIO := errors.New("io error")
+//	err := fmt.Errorf("read: %w", fmt.Errorf("%w: %w", ErrIO, ErrNotFound))
+//	a.ErrorChainEqual(err, []error{ErrIO, ErrNotFound}) // success
+//	a.ErrorChainEqual(err, []error{ErrNotFound, ErrIO}) // fail
+//	a.ErrorChainEqual(err, []error{ErrNotFound}) // fail
+//	a.ErrorChainEqual(err, []error{}) // fail
+func (a *Assertion) ErrorChainEqual(err error, expected []error, message ...any) error {
+	a.Helper()
+
+	return tryErrorChainEqual(a.testingT(), false, err, expected, message...)
+}
+
+// ErrorChainEqualNow tests whether the sentinel errors that wrapped by the error are the expected
+// errors in order, and it will terminate the execution if any expected error is missing, any other
+// sentinel error is wrapped, or they are in a different order.
+//
+//	a := assert.New(t)
+//	ErrNotFound := errors.New("not found")
+//	err := fmt.Errorf("read: %w", ErrNotFound)
+//	a.ErrorChainEqualNow(err, []error{ErrNotFound}) // success
+//	a.ErrorChainEqualNow(err, []error{io.EOF}) // fail and terminate
+//	// never runs
+func (a *Assertion) ErrorChainEqualNow(err error, expected []error, message ...any) error {
+	a.Helper()
+
+	return tryErrorChainEqual(a.testingT(), true, err, expected, message...)
+}
+
+// tryNoError tries to test whether the error is nil, and it'll fail if the error is not nil.
+func tryNoError(t testingT, failedNow bool, err error, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return err == nil },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageNoError, formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryHasError tries to test whether the error is not nil, and it'll fail if the error is nil.
+func tryHasError(t testingT, failedNow bool, err error, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return err != nil },
+		failedNow,
+		defaultErrMessageHasError,
+		message...,
+	)
+}
+
+// tryErrorAs tries to test whether any error in the chain matches the target, and it'll fail if
+// no error matches the target.
+func tryErrorAs(t testingT, failedNow bool, err error, target any, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return errors.As(err, target) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageErrorAs, getTargetTypeName(target), formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryErrorContains tries to test whether the message of the error contains the substring, and
+// it'll fail if the error is nil or the message does not contain the substring.
+func tryErrorContains(
+	t testingT,
+	failedNow bool,
+	err error,
+	substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return err != nil && strings.Contains(err.Error(), substr) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageErrorContains, substr, formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryErrorMatches tries to test whether the message of the error matches the pattern, and it'll
+// fail if the error is nil or the message does not match the pattern.
+func tryErrorMatches(
+	t testingT,
+	failedNow bool,
+	err error,
+	pattern string,
+	message ...any,
+) error {
+	t.Helper()
+
+	re := regexp.MustCompile(pattern)
+
+	return test(
+		t,
+		func() bool { return err != nil && re.MatchString(err.Error()) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageErrorMatches, pattern, formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryErrorType tries to test whether any error in the chain has the same type as the expected
+// error, and it'll fail if no error in the chain has the same type.
+func tryErrorType(t testingT, failedNow bool, err, expected error, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isErrorType(err, reflect.TypeOf(expected)) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageErrorType, expected, formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryErrorEqualMessage tries to test whether the message of the error equals to the expected
+// message, and it'll fail if the error is nil or the message is not the expected message.
+func tryErrorEqualMessage(
+	t testingT,
+	failedNow bool,
+	err error,
+	expected string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return err != nil && err.Error() == expected },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageErrorEqualMessage, expected, formatErrorTree(err)),
+		message...,
+	)
+}
+
+// tryErrorChainEqual tries to test whether the sentinel errors in the tree of the error are the
+// expected errors in order, and it'll fail if they are not the same.
+func tryErrorChainEqual(
+	t testingT,
+	failedNow bool,
+	err error,
+	expected []error,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isErrorChainEqual(err, expected) },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageErrorChainEqual,
+			formatErrorList(expected), formatErrorTree(err),
+		),
+		message...,
+	)
+}
+
+// getTargetTypeName returns the name of the type that the target of `errors.As` points to.
+func getTargetTypeName(target any) string {
+	if target == nil {
+		return "<nil>"
+	}
+
+	typ := reflect.TypeOf(target)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ.String()
+}
+
+// isErrorType checks whether any error in the tree of the error is the specific type.
+func isErrorType(err error, typ reflect.Type) bool {
+	for _, e := range getErrorTree(err) {
+		if reflect.TypeOf(e) == typ {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getSentinelErrors returns the errors in the tree of the error that do not wrap any other error,
+// and the order of them is the same as the order in the tree.
+func getSentinelErrors(err error) []error {
+	sentinels := make([]error, 0)
+
+	for _, e := range getErrorTree(err) {
+		if len(unwrapErrors(e)) == 0 {
+			sentinels = append(sentinels, e)
+		}
+	}
+
+	return sentinels
+}
+
+// isErrorChainEqual checks whether the sentinel errors in the tree of the error are the expected
+// errors in order.
+func isErrorChainEqual(err error, expected []error) bool {
+	sentinels := getSentinelErrors(err)
+	if len(sentinels) != len(expected) {
+		return false
+	}
+
+	for i, e := range sentinels {
+		if !reflect.TypeOf(e).Comparable() || e != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// unwrapErrors returns the errors that wrapped by the error directly, it supports both
+// `Unwrap() error` and `Unwrap() []error` methods.
+func unwrapErrors(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() error }:
+		if wrapped := e.Unwrap(); wrapped != nil {
+			return []error{wrapped}
+		}
+	case interface{ Unwrap() []error }:
+		return e.Unwrap()
+	}
+
+	return nil
+}
+
+// getErrorTree returns the error and all the errors that wrapped by it in the depth-first
+// pre-order.
+func getErrorTree(err error) []error {
+	tree := make([]error, 0)
+	if err == nil {
+		return tree
+	}
+
+	tree = append(tree, err)
+	for _, e := range unwrapErrors(err) {
+		tree = append(tree, getErrorTree(e)...)
+	}
+
+	return tree
+}
+
+// formatErrorTree formats the error as an indented tree with the types and messages of all the
+// errors that wrapped by it, for example:
+//
+//	"read config: file not found"
+//		- *fmt.wrapError: "read config: file not found"
+//			- *errors.errorString: "file not found"
+func formatErrorTree(err error) string {
+	if err == nil {
+		return "nil"
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("%q", err.Error()))
+	writeErrorTree(&builder, err, 1)
+
+	return builder.String()
+}
+
+// formatErrorList formats the errors as a list of their messages.
+func formatErrorList(errs []error) string {
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			messages = append(messages, "nil")
+		} else {
+			messages = append(messages, fmt.Sprintf("%q", err.Error()))
+		}
+	}
+
+	return "[" + strings.Join(messages, ", ") + "]"
+}
+
+// writeErrorTree writes the type and message of the error and its wrapped errors to the builder
+// with the indentation of the depth.
+func writeErrorTree(builder *strings.Builder, err error, depth int) {
+	if err == nil {
+		return
+	}
+
+	indent := strings.Repeat("\t", depth)
+	builder.WriteString(fmt.Sprintf("\n%s- %T: %q", indent, err, err.Error()))
+
+	for _, e := range unwrapErrors(err) {
+		writeErrorTree(builder, e, depth+1)
+	}
+}
@@ -4,6 +4,7 @@ package assert
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 )
 
@@ -19,3 +20,37 @@ func TestIsErrorWithJoinedErrors(t *testing.T) {
 	testIsError(a, mockA, errors.Join(err1, err2), err2, true)
 	testIsError(a, mockA, errors.Join(err1, err2), err3, false)
 }
+
+func TestErrorChainEqualWithJoinedErrors(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+	err3 := errors.New("error 3")
+	joinedErr := fmt.Errorf("outer: %w", errors.Join(err1, fmt.Errorf("%w: %w", err2, err3)))
+
+	testErrorChainEqual(a, mockA, joinedErr, []error{err1, err2, err3}, true)
+	testErrorChainEqual(a, mockA, joinedErr, []error{err1, err3}, false)
+	testErrorChainEqual(a, mockA, joinedErr, []error{}, false)
+
+	wrappedErr := fmt.Errorf("read: %w", fmt.Errorf("%w: %w", err1, err2))
+	testErrorChainEqual(a, mockA, wrappedErr, []error{err1, err2}, true)
+	testErrorChainEqual(a, mockA, wrappedErr, []error{err2, err1}, false)
+	testErrorChainEqual(a, mockA, wrappedErr, []error{err2}, false)
+	testErrorChainEqual(a, mockA, joinedErr, []error{err3, err2}, false)
+	testErrorChainEqual(a, mockA, joinedErr, []error{err1, err1}, false)
+}
+
+func TestFormatErrorTreeWithJoinedErrors(t *testing.T) {
+	a := New(t)
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+
+	a.EqualNow(
+		formatErrorTree(errors.Join(err1, err2)),
+		"\"error 1\\nerror 2\"\n\t- *errors.joinError: \"error 1\\nerror 2\""+
+			"\n\t\t- *errors.errorString: \"error 1\"\n\t\t- *errors.errorString: \"error 2\"",
+	)
+}
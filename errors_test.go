@@ -2,6 +2,10 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
 	"testing"
 )
 
@@ -50,3 +54,290 @@ func testIsError(a, mockA *Assertion, err, target error, isError bool) {
 		mockA.NotIsErrorNow(err, target)
 	}, isError)
 }
+
+func TestNoErrorAndHasError(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testNoErrorAndHasError(a, mockA, nil, true)
+	testNoErrorAndHasError(a, mockA, errors.New("test"), false)
+	testNoErrorAndHasError(a, mockA, fmt.Errorf("wrapped: %w", errors.New("test")), false)
+}
+
+func testNoErrorAndHasError(a, mockA *Assertion, err error, isNil bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "NoError", func() error {
+		return NoError(mockA.T, err)
+	}, isNil)
+	testAssertionFunction(a, "Assertion.NoError", func() error {
+		return mockA.NoError(err)
+	}, isNil)
+	testAssertionNowFunction(a, "NoErrorNow", func() {
+		NoErrorNow(mockA.T, err)
+	}, !isNil)
+	testAssertionNowFunction(a, "Assertion.NoErrorNow", func() {
+		mockA.NoErrorNow(err)
+	}, !isNil)
+
+	testAssertionFunction(a, "HasError", func() error {
+		return HasError(mockA.T, err)
+	}, !isNil)
+	testAssertionFunction(a, "Assertion.HasError", func() error {
+		return mockA.HasError(err)
+	}, !isNil)
+	testAssertionNowFunction(a, "HasErrorNow", func() {
+		HasErrorNow(mockA.T, err)
+	}, isNil)
+	testAssertionNowFunction(a, "Assertion.HasErrorNow", func() {
+		mockA.HasErrorNow(err)
+	}, isNil)
+}
+
+func TestErrorAs(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	_, pathErr := os.Open("not-exist-file")
+	wrappedErr := fmt.Errorf("wrapped: %w", pathErr)
+
+	testErrorAs(a, mockA, pathErr, true)
+	testErrorAs(a, mockA, wrappedErr, true)
+	testErrorAs(a, mockA, errors.New("test"), false)
+	testErrorAs(a, mockA, nil, false)
+
+	var target *fs.PathError
+	a.NotNilNow(mockA.ErrorAs(errors.New("test"), &target))
+	a.NilNow(target)
+	a.NilNow(mockA.ErrorAs(wrappedErr, &target))
+	a.NotNilNow(target)
+	a.EqualNow(target.Path, "not-exist-file")
+
+	a.PanicNow(func() {
+		mockA.ErrorAs(pathErr, target)
+	})
+}
+
+func testErrorAs(a, mockA *Assertion, err error, isOk bool) {
+	a.T.Helper()
+
+	var target *fs.PathError
+
+	testAssertionFunction(a, "ErrorAs", func() error {
+		return ErrorAs(mockA.T, err, &target)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorAs", func() error {
+		return mockA.ErrorAs(err, &target)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorAsNow", func() {
+		ErrorAsNow(mockA.T, err, &target)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorAsNow", func() {
+		mockA.ErrorAsNow(err, &target)
+	}, !isOk)
+}
+
+func TestErrorContains(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testErrorContains(a, mockA, errors.New("some error"), "some", true)
+	testErrorContains(a, mockA, errors.New("some error"), "", true)
+	testErrorContains(a, mockA, fmt.Errorf("wrapped: %w", errors.New("some error")), "some", true)
+	testErrorContains(a, mockA, errors.New("some error"), "other", false)
+	testErrorContains(a, mockA, nil, "", false)
+}
+
+func testErrorContains(a, mockA *Assertion, err error, substr string, isOk bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "ErrorContains", func() error {
+		return ErrorContains(mockA.T, err, substr)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorContains", func() error {
+		return mockA.ErrorContains(err, substr)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorContainsNow", func() {
+		ErrorContainsNow(mockA.T, err, substr)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorContainsNow", func() {
+		mockA.ErrorContainsNow(err, substr)
+	}, !isOk)
+}
+
+func TestErrorMatches(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testErrorMatches(a, mockA, errors.New("error code 404"), `code \d+`, true)
+	testErrorMatches(a, mockA, errors.New("error code 404"), `^error`, true)
+	testErrorMatches(a, mockA, errors.New("error code 404"), `^code`, false)
+	testErrorMatches(a, mockA, nil, `.*`, false)
+
+	a.PanicNow(func() {
+		mockA.ErrorMatches(errors.New("test"), `(`)
+	})
+}
+
+func testErrorMatches(a, mockA *Assertion, err error, pattern string, isOk bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "ErrorMatches", func() error {
+		return ErrorMatches(mockA.T, err, pattern)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorMatches", func() error {
+		return mockA.ErrorMatches(err, pattern)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorMatchesNow", func() {
+		ErrorMatchesNow(mockA.T, err, pattern)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorMatchesNow", func() {
+		mockA.ErrorMatchesNow(err, pattern)
+	}, !isOk)
+}
+
+func TestErrorType(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	_, pathErr := os.Open("not-exist-file")
+
+	testErrorType(a, mockA, pathErr, &fs.PathError{}, true)
+	testErrorType(a, mockA, fmt.Errorf("wrapped: %w", pathErr), &fs.PathError{}, true)
+	testErrorType(a, mockA, errors.New("test"), &fs.PathError{}, false)
+	testErrorType(a, mockA, nil, &fs.PathError{}, false)
+}
+
+func testErrorType(a, mockA *Assertion, err, expected error, isOk bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "ErrorType", func() error {
+		return ErrorType(mockA.T, err, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorType", func() error {
+		return mockA.ErrorType(err, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorTypeNow", func() {
+		ErrorTypeNow(mockA.T, err, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorTypeNow", func() {
+		mockA.ErrorTypeNow(err, expected)
+	}, !isOk)
+}
+
+func TestErrorEqualMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testErrorEqualMessage(a, mockA, errors.New("some error"), "some error", true)
+	testErrorEqualMessage(a, mockA, errors.New("some error"), "other error", false)
+	testErrorEqualMessage(a, mockA, errors.New("some error"), "some", false)
+	testErrorEqualMessage(a, mockA, nil, "", false)
+}
+
+func testErrorEqualMessage(a, mockA *Assertion, err error, expected string, isOk bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "ErrorEqualMessage", func() error {
+		return ErrorEqualMessage(mockA.T, err, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorEqualMessage", func() error {
+		return mockA.ErrorEqualMessage(err, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorEqualMessageNow", func() {
+		ErrorEqualMessageNow(mockA.T, err, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorEqualMessageNow", func() {
+		mockA.ErrorEqualMessageNow(err, expected)
+	}, !isOk)
+}
+
+type testMultiError struct {
+	errs []error
+}
+
+func (e *testMultiError) Error() string {
+	messages := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+func (e *testMultiError) Unwrap() []error {
+	return e.errs
+}
+
+func TestErrorChainEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+	err3 := errors.New("error 3")
+	chainErr := fmt.Errorf("outer: %w", fmt.Errorf("inner: %w", err1))
+	multiErr := fmt.Errorf("outer: %w", &testMultiError{errs: []error{err1, err2}})
+
+	testErrorChainEqual(a, mockA, chainErr, []error{err1}, true)
+	testErrorChainEqual(a, mockA, chainErr, []error{}, false)
+	testErrorChainEqual(a, mockA, chainErr, []error{err2}, false)
+	testErrorChainEqual(a, mockA, chainErr, []error{err1, err2}, false)
+	testErrorChainEqual(a, mockA, multiErr, []error{err1, err2}, true)
+	testErrorChainEqual(a, mockA, multiErr, []error{err1}, false)
+	testErrorChainEqual(a, mockA, multiErr, []error{err2}, false)
+	testErrorChainEqual(a, mockA, multiErr, []error{err2, err1}, false)
+	testErrorChainEqual(a, mockA, multiErr, []error{err1, err2, err3}, false)
+	testErrorChainEqual(a, mockA, nil, []error{}, true)
+	testErrorChainEqual(a, mockA, nil, []error{err1}, false)
+	testErrorChainEqual(a, mockA, nil, nil, true)
+	testErrorChainEqual(a, mockA, &testMultiError{}, []error{}, false)
+	testErrorChainEqual(a, mockA, &testMultiError{}, []error{&testMultiError{}}, false)
+}
+
+func testErrorChainEqual(a, mockA *Assertion, err error, expected []error, isOk bool) {
+	a.T.Helper()
+
+	testAssertionFunction(a, "ErrorChainEqual", func() error {
+		return ErrorChainEqual(mockA.T, err, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ErrorChainEqual", func() error {
+		return mockA.ErrorChainEqual(err, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "ErrorChainEqualNow", func() {
+		ErrorChainEqualNow(mockA.T, err, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ErrorChainEqualNow", func() {
+		mockA.ErrorChainEqualNow(err, expected)
+	}, !isOk)
+}
+
+func TestFormatErrorTree(t *testing.T) {
+	a := New(t)
+
+	err1 := errors.New("error 1")
+	err2 := errors.New("error 2")
+	wrapped := fmt.Errorf("outer: %w", err1)
+	multiErr := fmt.Errorf("outer: %w", &testMultiError{errs: []error{err1, wrapped}})
+
+	a.EqualNow(formatErrorTree(nil), "nil")
+	a.EqualNow(formatErrorTree(err1), "\"error 1\"\n\t- *errors.errorString: \"error 1\"")
+	a.EqualNow(
+		formatErrorTree(wrapped),
+		"\"outer: error 1\"\n\t- *fmt.wrapError: \"outer: error 1\""+
+			"\n\t\t- *errors.errorString: \"error 1\"",
+	)
+	a.EqualNow(formatErrorTree(multiErr), strings.Join([]string{
+		"\"outer: error 1; outer: error 1\"",
+		"\t- *fmt.wrapError: \"outer: error 1; outer: error 1\"",
+		"\t\t- *assert.testMultiError: \"error 1; outer: error 1\"",
+		"\t\t\t- *errors.errorString: \"error 1\"",
+		"\t\t\t- *fmt.wrapError: \"outer: error 1\"",
+		"\t\t\t\t- *errors.errorString: \"error 1\"",
+	}, "\n"))
+
+	a.EqualNow(formatErrorList([]error{err1, nil, err2}), "[\"error 1\", nil, \"error 2\"]")
+
+	err := IsError(new(testing.T), wrapped, err2)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect err matches error 2, got "+formatErrorTree(wrapped))
+}
@@ -0,0 +1,323 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// FloatTolerance is the tolerance of the floating numbers comparison. Two numbers are equal if
+// they are identical, or their difference satisfies any of the non-zero tolerances. For complex
+// numbers, both the real parts and the imaginary parts must satisfy the tolerance.
+//
+// Infinities are only equal to the infinities with the same sign unless InfNotEqual is set, and
+// NaNs are never equal to any value unless NaNEqual is set. The tolerances are never applied to the
+// infinities, so the largest finite number is not equal to the infinity even if they are only one
+// ULP apart.
+type FloatTolerance struct {
+	// Abs is the maximum absolute difference between the numbers.
+	Abs float64
+	// Rel is the maximum relative difference between the numbers, it is relative to the larger
+	// magnitude of the numbers.
+	Rel float64
+	// ULP is the maximum distance between the numbers in units in the last place. The distance will
+	// be calculated in single precision if either number is a float32 or a complex64.
+	ULP uint64
+	// NaNEqual indicates whether a NaN equals to another NaN or not.
+	NaNEqual bool
+	// InfNotEqual indicates whether an infinity never equals to any value, including the infinity
+	// with the same sign, for example, to catch the overflows of the calculations.
+	InfNotEqual bool
+}
+
+// FloatEqualWith tests the equality between actual and expect numbers with the tolerance, and it
+// set the result to fail if they are not equal. It supports floating numbers, complex numbers,
+// and integers.
+//
+//	a := assert.New(t)
+//	a.FloatEqualWith(1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // success
+//	a.FloatEqualWith(1.0, math.Nextafter(1.0, 2), assert.FloatTolerance{ULP: 1}) // success
+//	a.FloatEqualWith(math.NaN(), math.NaN(), assert.FloatTolerance{NaNEqual: true}) // success
+//	a.FloatEqualWith(complex(1, 1), complex(1, 1.01), assert.FloatTolerance{Abs: 0.1}) // success
+//	a.FloatEqualWith(1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // fail
+func (a *Assertion) FloatEqualWith(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatEqualWith(a.testingT(), false, actual, expect, tolerance, message...)
+}
+
+// FloatEqualWithNow tests the equality between actual and expect numbers with the tolerance, and
+// it will terminate the execution if they are not equal.
+//
+//	a := assert.New(t)
+//	a.FloatEqualWithNow(1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // success
+//	a.FloatEqualWithNow(1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // fail and terminate
+//	// never runs
+func (a *Assertion) FloatEqualWithNow(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatEqualWith(a.testingT(), true, actual, expect, tolerance, message...)
+}
+
+// FloatNotEqualWith tests the inequality between actual and expect numbers with the tolerance,
+// and it set the result to fail if they are equal.
+//
+//	a := assert.New(t)
+//	a.FloatNotEqualWith(1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // success
+//	a.FloatNotEqualWith(math.NaN(), math.NaN(), assert.FloatTolerance{}) // success
+//	a.FloatNotEqualWith(1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // fail
+func (a *Assertion) FloatNotEqualWith(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatNotEqualWith(a.testingT(), false, actual, expect, tolerance, message...)
+}
+
+// FloatNotEqualWithNow tests the inequality between actual and expect numbers with the tolerance,
+// and it will terminate the execution if they are equal.
+//
+//	a := assert.New(t)
+//	a.FloatNotEqualWithNow(1.0, 1.1, assert.FloatTolerance{Abs: 0.01}) // success
+//	a.FloatNotEqualWithNow(1000.0, 1000.1, assert.FloatTolerance{Rel: 0.001}) // fail and terminate
+//	// never runs
+func (a *Assertion) FloatNotEqualWithNow(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatNotEqualWith(a.testingT(), true, actual, expect, tolerance, message...)
+}
+
+// FloatsEqual tests the element-wise equality between the actual and expect slices or arrays of
+// numbers with the tolerance, and it set the result to fail if they have different lengths or any
+// pair of the elements is not equal. It also supports nested slices or arrays like matrices, and
+// the index of the first mismatched element will be reported in the message.
+//
+//	a := assert.New(t)
+//	tol := assert.FloatTolerance{Abs: 0.01}
+//	a.FloatsEqual([]float64{1.0, 2.0}, []float64{1.0, 2.001}, tol) // success
+//	a.FloatsEqual([][]float64{{1.0}, {2.0}}, [][]float64{{1.0}, {2.1}}, tol) // fail
+func (a *Assertion) FloatsEqual(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatsEqual(a.testingT(), false, actual, expect, tolerance, message...)
+}
+
+// FloatsEqualNow tests the element-wise equality between the actual and expect slices or arrays
+// of numbers with the tolerance, and it will terminate the execution if they have different
+// lengths or any pair of the elements is not equal.
+//
+//	a := assert.New(t)
+//	tol := assert.FloatTolerance{Abs: 0.01}
+//	a.FloatsEqualNow([]float64{1.0, 2.0}, []float64{1.0, 2.001}, tol) // success
+//	a.FloatsEqualNow([]float64{1.0, 2.0}, []float64{1.0}, tol) // fail and terminate
+//	// never runs
+func (a *Assertion) FloatsEqualNow(
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryFloatsEqual(a.testingT(), true, actual, expect, tolerance, message...)
+}
+
+// tryFloatEqualWith tries to test the equality between the numbers with the tolerance, and it'll
+// fail if they are not equal.
+func tryFloatEqualWith(
+	t testingT,
+	failedNow bool,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isFloatEqualWith(actual, expect, tolerance) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageFloatEqualWith, actual, expect, tolerance),
+		message...,
+	)
+}
+
+// tryFloatNotEqualWith tries to test the inequality between the numbers with the tolerance, and
+// it'll fail if they are equal.
+func tryFloatNotEqualWith(
+	t testingT,
+	failedNow bool,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return !isFloatEqualWith(actual, expect, tolerance) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageFloatNotEqualWith, actual, expect, tolerance),
+		message...,
+	)
+}
+
+// tryFloatsEqual tries to test the element-wise equality between the slices or arrays with the
+// tolerance, and it'll fail if any pair of the elements is not equal.
+func tryFloatsEqual(
+	t testingT,
+	failedNow bool,
+	actual, expect any,
+	tolerance FloatTolerance,
+	message ...any,
+) error {
+	t.Helper()
+
+	diff := getFloatsDiff(reflect.ValueOf(actual), reflect.ValueOf(expect), tolerance, "")
+
+	return test(
+		t,
+		func() bool { return diff == "" },
+		failedNow,
+		diff,
+		message...,
+	)
+}
+
+// getFloatsDiff compares the slices or arrays element-wisely, and returns the description of the
+// first difference with the index path. It returns an empty string if they are equal.
+func getFloatsDiff(v1, v2 reflect.Value, tolerance FloatTolerance, path string) string {
+	for v1.Kind() == reflect.Interface || v1.Kind() == reflect.Ptr {
+		v1 = v1.Elem()
+	}
+	for v2.Kind() == reflect.Interface || v2.Kind() == reflect.Ptr {
+		v2 = v2.Elem()
+	}
+
+	isList1 := v1.Kind() == reflect.Slice || v1.Kind() == reflect.Array
+	isList2 := v2.Kind() == reflect.Slice || v2.Kind() == reflect.Array
+
+	if !isList1 || !isList2 {
+		if path == "" {
+			panic(ErrNotArray)
+		}
+		if !v1.IsValid() || !v2.IsValid() || isList1 || isList2 ||
+			!isFloatEqualWith(v1.Interface(), v2.Interface(), tolerance) {
+			return fmt.Sprintf(defaultErrMessageFloatsEqual, v1, v2, path)
+		}
+		return ""
+	}
+
+	if v1.Len() != v2.Len() {
+		at := ""
+		if path != "" {
+			at = " at index " + path
+		}
+		return fmt.Sprintf(defaultErrMessageFloatsLength, at, v1.Len(), v2.Len())
+	}
+
+	for i := 0; i < v1.Len(); i++ {
+		diff := getFloatsDiff(v1.Index(i), v2.Index(i), tolerance, fmt.Sprintf("%s[%d]", path, i))
+		if diff != "" {
+			return diff
+		}
+	}
+
+	return ""
+}
+
+// isFloatEqualWith checks the equality of two numbers with the tolerance. It supports floating
+// numbers, complex numbers, and the numbers that can be converted to floating numbers.
+func isFloatEqualWith(x, y any, tolerance FloatTolerance) bool {
+	isSingle := isSinglePrecision(x) || isSinglePrecision(y)
+
+	if isComplex(x) || isComplex(y) {
+		xc := toComplex(x)
+		yc := toComplex(y)
+
+		return isFloatValueEqualWith(real(xc), real(yc), tolerance, isSingle) &&
+			isFloatValueEqualWith(imag(xc), imag(yc), tolerance, isSingle)
+	}
+
+	return isFloatValueEqualWith(toFloat(x), toFloat(y), tolerance, isSingle)
+}
+
+// isFloatValueEqualWith checks the equality of two floating numbers with the tolerance.
+func isFloatValueEqualWith(x, y float64, tolerance FloatTolerance, isSingle bool) bool {
+	if math.IsNaN(x) || math.IsNaN(y) {
+		return tolerance.NaNEqual && math.IsNaN(x) && math.IsNaN(y)
+	} else if math.IsInf(x, 0) || math.IsInf(y, 0) {
+		return !tolerance.InfNotEqual && x == y
+	} else if x == y {
+		return true
+	}
+
+	diff := math.Abs(x - y)
+
+	if diff <= tolerance.Abs {
+		return true
+	}
+	if tolerance.Rel > 0 && diff <= tolerance.Rel*math.Max(math.Abs(x), math.Abs(y)) {
+		return true
+	}
+	if tolerance.ULP > 0 && getULPDistance(x, y, isSingle) <= tolerance.ULP {
+		return true
+	}
+
+	return false
+}
+
+// getULPDistance returns the number of representable floating numbers between x and y.
+func getULPDistance(x, y float64, isSingle bool) uint64 {
+	var i1, i2 int64
+
+	if isSingle {
+		i1 = int64(toOrderedBits32(float32(x)))
+		i2 = int64(toOrderedBits32(float32(y)))
+	} else {
+		i1 = toOrderedBits64(x)
+		i2 = toOrderedBits64(y)
+	}
+
+	if i1 > i2 {
+		return uint64(i1) - uint64(i2)
+	}
+	return uint64(i2) - uint64(i1)
+}
+
+// toOrderedBits64 converts the bits of the float64 number to an integer, and the order of the
+// integers is the same as the order of the floating numbers.
+func toOrderedBits64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		bits = math.MinInt64 - bits
+	}
+	return bits
+}
+
+// toOrderedBits32 converts the bits of the float32 number to an integer, and the order of the
+// integers is the same as the order of the floating numbers.
+func toOrderedBits32(f float32) int32 {
+	bits := int32(math.Float32bits(f))
+	if bits < 0 {
+		bits = math.MinInt32 - bits
+	}
+	return bits
+}
@@ -0,0 +1,137 @@
+package assert
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatEqualWith(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	nan := math.NaN()
+	inf := math.Inf(1)
+
+	testFloatEqualWith(a, mockA, 1.0, 1.0, FloatTolerance{}, true)
+	testFloatEqualWith(a, mockA, 1.0, 1.1, FloatTolerance{}, false)
+	testFloatEqualWith(a, mockA, 1.0, 1.1, FloatTolerance{Abs: 0.2}, true)
+	testFloatEqualWith(a, mockA, 1.0, 1.1, FloatTolerance{Abs: 0.01}, false)
+	testFloatEqualWith(a, mockA, 1000.0, 1000.1, FloatTolerance{Rel: 0.001}, true)
+	testFloatEqualWith(a, mockA, 1.0, 1.1, FloatTolerance{Rel: 0.001}, false)
+	testFloatEqualWith(a, mockA, 1e-20, 0.0, FloatTolerance{Rel: 0.001}, false)
+	testFloatEqualWith(a, mockA, 1e-20, 0.0, FloatTolerance{Abs: 1e-12, Rel: 0.001}, true)
+	testFloatEqualWith(a, mockA, 1.0, math.Nextafter(1.0, 2), FloatTolerance{ULP: 1}, true)
+	testFloatEqualWith(a, mockA, 1.0, math.Nextafter(math.Nextafter(1.0, 2), 2), FloatTolerance{ULP: 1}, false)
+	testFloatEqualWith(a, mockA, math.Copysign(0, -1), math.SmallestNonzeroFloat64, FloatTolerance{ULP: 1}, true)
+	testFloatEqualWith(a, mockA, float32(1.0), float32(math.Nextafter32(1.0, 2)), FloatTolerance{ULP: 1}, true)
+	testFloatEqualWith(a, mockA, nan, nan, FloatTolerance{Abs: 1}, false)
+	testFloatEqualWith(a, mockA, nan, nan, FloatTolerance{NaNEqual: true}, true)
+	testFloatEqualWith(a, mockA, nan, 1.0, FloatTolerance{NaNEqual: true}, false)
+	testFloatEqualWith(a, mockA, inf, inf, FloatTolerance{}, true)
+	testFloatEqualWith(a, mockA, inf, -inf, FloatTolerance{Abs: inf}, false)
+	testFloatEqualWith(a, mockA, inf, math.MaxFloat64, FloatTolerance{Rel: 1}, false)
+	testFloatEqualWith(a, mockA, inf, math.MaxFloat64, FloatTolerance{ULP: 1}, false)
+	testFloatEqualWith(a, mockA, inf, inf, FloatTolerance{Rel: 0.1, ULP: 1}, true)
+	testFloatEqualWith(a, mockA, inf, inf, FloatTolerance{InfNotEqual: true}, false)
+	testFloatEqualWith(a, mockA, -inf, -inf, FloatTolerance{Abs: inf, InfNotEqual: true}, false)
+	testFloatEqualWith(a, mockA, 1.0, 1.0, FloatTolerance{InfNotEqual: true}, true)
+	testFloatEqualWith(a, mockA, complex(inf, 1), complex(inf, 1), FloatTolerance{InfNotEqual: true}, false)
+	testFloatEqualWith(a, mockA, complex(1, 1), complex(1, 1.01), FloatTolerance{Abs: 0.1}, true)
+	testFloatEqualWith(a, mockA, complex(1, 1), complex(1, 1.2), FloatTolerance{Abs: 0.1}, false)
+	testFloatEqualWith(a, mockA, complex64(complex(1, 0)), 1.0, FloatTolerance{}, true)
+	testFloatEqualWith(a, mockA, 1, 1.0, FloatTolerance{}, true)
+
+	a.PanicOfNow(func() {
+		mockA.FloatEqualWith(nil, 1.0, FloatTolerance{})
+	}, ErrNotFloat)
+	a.PanicOfNow(func() {
+		mockA.FloatEqualWith(1.0, nil, FloatTolerance{})
+	}, ErrNotFloat)
+}
+
+func testFloatEqualWith(a, mockA *Assertion, actual, expect any, tolerance FloatTolerance, isEqual bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "FloatEqualWith", func() error {
+		return FloatEqualWith(mockA.T, actual, expect, tolerance)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion.FloatEqualWith", func() error {
+		return mockA.FloatEqualWith(actual, expect, tolerance)
+	}, isEqual)
+	testAssertionNowFunction(a, "FloatEqualWithNow", func() {
+		FloatEqualWithNow(mockA.T, actual, expect, tolerance)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion.FloatEqualWithNow", func() {
+		mockA.FloatEqualWithNow(actual, expect, tolerance)
+	}, !isEqual)
+
+	testAssertionFunction(a, "FloatNotEqualWith", func() error {
+		return FloatNotEqualWith(mockA.T, actual, expect, tolerance)
+	}, !isEqual)
+	testAssertionFunction(a, "Assertion.FloatNotEqualWith", func() error {
+		return mockA.FloatNotEqualWith(actual, expect, tolerance)
+	}, !isEqual)
+	testAssertionNowFunction(a, "FloatNotEqualWithNow", func() {
+		FloatNotEqualWithNow(mockA.T, actual, expect, tolerance)
+	}, isEqual)
+	testAssertionNowFunction(a, "Assertion.FloatNotEqualWithNow", func() {
+		mockA.FloatNotEqualWithNow(actual, expect, tolerance)
+	}, isEqual)
+}
+
+func TestFloatsEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	tol := FloatTolerance{Abs: 0.01}
+
+	a.PanicOfNow(func() {
+		mockA.FloatsEqual(1.0, 1.0, tol)
+	}, ErrNotArray)
+
+	testFloatsEqual(a, mockA, []float64{}, []float64{}, tol, true)
+	testFloatsEqual(a, mockA, []float64{1.0, 2.0}, []float64{1.0, 2.001}, tol, true)
+	testFloatsEqual(a, mockA, []float64{1.0, 2.0}, []float64{1.0, 2.1}, tol, false)
+	testFloatsEqual(a, mockA, []float64{1.0, 2.0}, []float64{1.0}, tol, false)
+	testFloatsEqual(a, mockA, [2]float32{1.0, 2.0}, []float64{1.0, 2.0}, tol, true)
+	testFloatsEqual(a, mockA, []complex128{complex(1, 1)}, []complex128{complex(1, 1.001)}, tol, true)
+	testFloatsEqual(a, mockA, [][]float64{{1.0}, {2.0, 3.0}}, [][]float64{{1.0}, {2.0, 3.0}}, tol, true)
+	testFloatsEqual(a, mockA, [][]float64{{1.0}, {2.0, 3.0}}, [][]float64{{1.0}, {2.0, 3.1}}, tol, false)
+	testFloatsEqual(a, mockA, [][]float64{{1.0}, {2.0, 3.0}}, [][]float64{{1.0}, {2.0}}, tol, false)
+	testFloatsEqual(a, mockA, []any{1.0, []float64{2.0}}, []any{1.0, 2.0}, tol, false)
+
+	err := New(new(testing.T)).FloatsEqual([][]float64{{1.0}, {2.0, 3.0}}, [][]float64{{1.0}, {2.0, 3.1}}, tol)
+	a.EqualNow(err.Error(), "assert error: 3 != 3.1 at index [1][1]")
+	err = New(new(testing.T)).FloatsEqual([][]float64{{1.0}, {2.0, 3.0}}, [][]float64{{1.0}, {2.0}}, tol)
+	a.EqualNow(err.Error(), "assert error: the lengths are not equal at index [1]: 2 != 1")
+	err = New(new(testing.T)).FloatsEqual([]float64{1.0}, []float64{}, tol)
+	a.EqualNow(err.Error(), "assert error: the lengths are not equal: 1 != 0")
+}
+
+func testFloatsEqual(a, mockA *Assertion, actual, expect any, tolerance FloatTolerance, isEqual bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "FloatsEqual", func() error {
+		return FloatsEqual(mockA.T, actual, expect, tolerance)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion.FloatsEqual", func() error {
+		return mockA.FloatsEqual(actual, expect, tolerance)
+	}, isEqual)
+	testAssertionNowFunction(a, "FloatsEqualNow", func() {
+		FloatsEqualNow(mockA.T, actual, expect, tolerance)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion.FloatsEqualNow", func() {
+		mockA.FloatsEqualNow(actual, expect, tolerance)
+	}, !isEqual)
+}
+
+func TestGetULPDistance(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(getULPDistance(1.0, 1.0, false), uint64(0))
+	a.EqualNow(getULPDistance(1.0, math.Nextafter(1.0, 2), false), uint64(1))
+	a.EqualNow(getULPDistance(math.Nextafter(1.0, 2), 1.0, false), uint64(1))
+	a.EqualNow(getULPDistance(0, math.Copysign(0, -1), false), uint64(0))
+	a.EqualNow(getULPDistance(-math.SmallestNonzeroFloat64, math.SmallestNonzeroFloat64, false), uint64(2))
+	a.EqualNow(getULPDistance(1.0, float64(math.Nextafter32(1.0, 2)), true), uint64(1))
+}
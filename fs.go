@@ -0,0 +1,493 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"regexp"
+	"unicode/utf8"
+)
+
+// FileExists tests whether the file exists and is not a directory. The file will be opened from
+// the file system fsys, or the OS file system if fsys is nil. It'll set the result to fail if the
+// file does not exist, or it is a directory.
+//
+//	a := assert.New(t)
+//	a.FileExists(nil, "testdata/out.txt") // success
+//	a.FileExists(fstest.MapFS{"a.txt": {}}, "a.txt") // success
+//	a.FileExists(nil, "testdata") // fail
+func (a *Assertion) FileExists(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryFileExists(a.testingT(), false, fsys, name, message...)
+}
+
+// FileExistsNow tests whether the file exists and is not a directory. It'll terminate the
+// execution if the file does not exist, or it is a directory.
+//
+//	a := assert.New(t)
+//	a.FileExistsNow(nil, "testdata/out.txt") // success
+//	a.FileExistsNow(nil, "testdata") // fail and terminate
+//	// never runs
+func (a *Assertion) FileExistsNow(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryFileExists(a.testingT(), true, fsys, name, message...)
+}
+
+// NoFileExists tests whether the file does not exist or it is a directory. The file will be opened
+// from the file system fsys, or the OS file system if fsys is nil. It'll set the result to fail if
+// the file exists and is not a directory, or it cannot tell whether the file exists, for example,
+// the permission is denied or the name is not a valid path of fsys.
+//
+//	a := assert.New(t)
+//	a.NoFileExists(nil, "testdata/not-exist.txt") // success
+//	a.NoFileExists(nil, "testdata/out.txt") // fail
+func (a *Assertion) NoFileExists(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryNoFileExists(a.testingT(), false, fsys, name, message...)
+}
+
+// NoFileExistsNow tests whether the file does not exist or it is a directory. It'll terminate the
+// execution if the file exists and is not a directory, or it cannot tell whether the file exists.
+//
+//	a := assert.New(t)
+//	a.NoFileExistsNow(nil, "testdata/not-exist.txt") // success
+//	a.NoFileExistsNow(nil, "testdata/out.txt") // fail and terminate
+//	// never runs
+func (a *Assertion) NoFileExistsNow(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryNoFileExists(a.testingT(), true, fsys, name, message...)
+}
+
+// DirExists tests whether the directory exists. The directory will be opened from the file system
+// fsys, or the OS file system if fsys is nil. It'll set the result to fail if the directory does
+// not exist, or it is not a directory.
+//
+//	a := assert.New(t)
+//	a.DirExists(nil, "testdata") // success
+//	a.DirExists(nil, "testdata/out.txt") // fail
+func (a *Assertion) DirExists(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryDirExists(a.testingT(), false, fsys, name, message...)
+}
+
+// DirExistsNow tests whether the directory exists. It'll terminate the execution if the directory
+// does not exist, or it is not a directory.
+//
+//	a := assert.New(t)
+//	a.DirExistsNow(nil, "testdata") // success
+//	a.DirExistsNow(nil, "testdata/out.txt") // fail and terminate
+//	// never runs
+func (a *Assertion) DirExistsNow(fsys fs.FS, name string, message ...any) error {
+	a.Helper()
+
+	return tryDirExists(a.testingT(), true, fsys, name, message...)
+}
+
+// FileMode tests whether the mode of the file is the expected mode, including the type bits and
+// the permission bits. The file will be opened from the file system fsys, or the OS file system
+// if fsys is nil. It'll set the result to fail if the file does not exist, or the mode is not the
+// expected mode.
+//
+//	a := assert.New(t)
+//	a.FileMode(nil, "testdata/out.txt", 0644) // success
+//	a.FileMode(nil, "testdata", fs.ModeDir|0755) // success
+//	a.FileMode(nil, "testdata/out.txt", 0755) // fail
+func (a *Assertion) FileMode(fsys fs.FS, name string, mode fs.FileMode, message ...any) error {
+	a.Helper()
+
+	return tryFileMode(a.testingT(), false, fsys, name, mode, message...)
+}
+
+// FileModeNow tests whether the mode of the file is the expected mode, including the type bits and
+// the permission bits. It'll terminate the execution if the file does not exist, or the mode is
+// not the expected mode.
+//
+//	a := assert.New(t)
+//	a.FileModeNow(nil, "testdata/out.txt", 0644) // success
+//	a.FileModeNow(nil, "testdata/out.txt", 0755) // fail and terminate
+//	// never runs
+func (a *Assertion) FileModeNow(fsys fs.FS, name string, mode fs.FileMode, message ...any) error {
+	a.Helper()
+
+	return tryFileMode(a.testingT(), true, fsys, name, mode, message...)
+}
+
+// FileContentEqual tests whether the content of the file is the expected content. The file will
+// be read from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail with the diff of the contents if the file cannot be read, or the content is not the
+// expected content.
+//
+//	a := assert.New(t)
+//	a.FileContentEqual(nil, "testdata/out.txt", "Hello world\n") // success
+//	a.FileContentEqual(nil, "testdata/out.txt", "Hello\n") // fail
+func (a *Assertion) FileContentEqual(fsys fs.FS, name, expected string, message ...any) error {
+	a.Helper()
+
+	return tryFileContentEqual(a.testingT(), false, fsys, name, expected, message...)
+}
+
+// FileContentEqualNow tests whether the content of the file is the expected content. It'll
+// terminate the execution if the file cannot be read, or the content is not the expected content.
+//
+//	a := assert.New(t)
+//	a.FileContentEqualNow(nil, "testdata/out.txt", "Hello world\n") // success
+//	a.FileContentEqualNow(nil, "testdata/out.txt", "Hello\n") // fail and terminate
+//	// never runs
+func (a *Assertion) FileContentEqualNow(fsys fs.FS, name, expected string, message ...any) error {
+	a.Helper()
+
+	return tryFileContentEqual(a.testingT(), true, fsys, name, expected, message...)
+}
+
+// FileContains tests whether the content of the file contains the substring. The file will be
+// read from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail if the file cannot be read, or the content does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.FileContains(nil, "testdata/out.txt", "Hello") // success
+//	a.FileContains(nil, "testdata/out.txt", "Goodbye") // fail
+func (a *Assertion) FileContains(fsys fs.FS, name, substr string, message ...any) error {
+	a.Helper()
+
+	return tryFileContains(a.testingT(), false, fsys, name, substr, message...)
+}
+
+// FileContainsNow tests whether the content of the file contains the substring. It'll terminate
+// the execution if the file cannot be read, or the content does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.FileContainsNow(nil, "testdata/out.txt", "Hello") // success
+//	a.FileContainsNow(nil, "testdata/out.txt", "Goodbye") // fail and terminate
+//	// never runs
+func (a *Assertion) FileContainsNow(fsys fs.FS, name, substr string, message ...any) error {
+	a.Helper()
+
+	return tryFileContains(a.testingT(), true, fsys, name, substr, message...)
+}
+
+// FileMatches tests whether the content of the file matches the regular expression pattern. The
+// file will be read from the file system fsys, or the OS file system if fsys is nil. It'll set the
+// result to fail if the file cannot be read, or the content does not match the pattern. It'll
+// panic if the pattern is not a valid regular expression.
+//
+//	a := assert.New(t)
+//	a.FileMatches(nil, "testdata/out.txt", `^Hello \w+`) // success
+//	a.FileMatches(nil, "testdata/out.txt", `^Goodbye`) // fail
+func (a *Assertion) FileMatches(fsys fs.FS, name, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryFileMatches(a.testingT(), false, fsys, name, pattern, message...)
+}
+
+// FileMatchesNow tests whether the content of the file matches the regular expression pattern.
+// It'll terminate the execution if the file cannot be read, or the content does not match the
+// pattern.
+//
+//	a := assert.New(t)
+//	a.FileMatchesNow(nil, "testdata/out.txt", `^Hello \w+`) // success
+//	a.FileMatchesNow(nil, "testdata/out.txt", `^Goodbye`) // fail and terminate
+//	// never runs
+func (a *Assertion) FileMatchesNow(fsys fs.FS, name, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryFileMatches(a.testingT(), true, fsys, name, pattern, message...)
+}
+
+// FileSize tests whether the size of the file in bytes is the expected size. The file will be
+// opened from the file system fsys, or the OS file system if fsys is nil. It'll set the result to
+// fail if the file does not exist, or the size is not the expected size.
+//
+//	a := assert.New(t)
+//	a.FileSize(nil, "testdata/out.txt", 12) // success
+//	a.FileSize(nil, "testdata/out.txt", 0) // fail
+func (a *Assertion) FileSize(fsys fs.FS, name string, size int64, message ...any) error {
+	a.Helper()
+
+	return tryFileSize(a.testingT(), false, fsys, name, size, message...)
+}
+
+// FileSizeNow tests whether the size of the file in bytes is the expected size. It'll terminate
+// the execution if the file does not exist, or the size is not the expected size.
+//
+//	a := assert.New(t)
+//	a.FileSizeNow(nil, "testdata/out.txt", 12) // success
+//	a.FileSizeNow(nil, "testdata/out.txt", 0) // fail and terminate
+//	// never runs
+func (a *Assertion) FileSizeNow(fsys fs.FS, name string, size int64, message ...any) error {
+	a.Helper()
+
+	return tryFileSize(a.testingT(), true, fsys, name, size, message...)
+}
+
+// tryFileExists tries to test whether the file exists and is not a directory, and it'll fail if
+// the file does not exist or it is a directory.
+func tryFileExists(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name string,
+	message ...any,
+) error {
+	t.Helper()
+
+	info, err := statFile(fsys, name)
+
+	return test(
+		t,
+		func() bool { return err == nil && !info.IsDir() },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageFileExists, name, getFileTypeDescription(info, err)),
+		message...,
+	)
+}
+
+// tryNoFileExists tries to test whether the file does not exist or it is a directory, and it'll
+// fail if the file exists and is not a directory, or the file cannot be accessed.
+func tryNoFileExists(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name string,
+	message ...any,
+) error {
+	t.Helper()
+
+	info, err := statFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageNoFileExists, name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		defaultMessage = fmt.Sprintf(defaultErrMessageFileError, name, err)
+	}
+
+	return test(
+		t,
+		func() bool { return errors.Is(err, fs.ErrNotExist) || (err == nil && info.IsDir()) },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryDirExists tries to test whether the directory exists, and it'll fail if the directory does
+// not exist or it is not a directory.
+func tryDirExists(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name string,
+	message ...any,
+) error {
+	t.Helper()
+
+	info, err := statFile(fsys, name)
+
+	return test(
+		t,
+		func() bool { return err == nil && info.IsDir() },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageDirExists, name, getFileTypeDescription(info, err)),
+		message...,
+	)
+}
+
+// tryFileMode tries to test whether the mode of the file is the expected mode, and it'll fail if
+// the file does not exist or the mode is not the expected mode.
+func tryFileMode(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name string,
+	mode fs.FileMode,
+	message ...any,
+) error {
+	t.Helper()
+
+	info, err := statFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageFileError, name, err)
+	if err == nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageFileMode, name, mode, info.Mode())
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && info.Mode() == mode },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryFileContentEqual tries to test whether the content of the file is the expected content, and
+// it'll fail if the file cannot be read or the content is not the expected content.
+func tryFileContentEqual(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name, expected string,
+	message ...any,
+) error {
+	t.Helper()
+
+	content, err := readFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageFileError, name, err)
+	if err == nil {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageFileContentEqual,
+			name, formatContentDiff([]byte(expected), content),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && string(content) == expected },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryFileContains tries to test whether the content of the file contains the substring, and it'll
+// fail if the file cannot be read or the content does not contain the substring.
+func tryFileContains(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name, substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	content, err := readFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageFileError, name, err)
+	if err == nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageFileContains, name, substr)
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && bytes.Contains(content, []byte(substr)) },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryFileMatches tries to test whether the content of the file matches the regular expression
+// pattern, and it'll fail if the file cannot be read or the content does not match the pattern.
+func tryFileMatches(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name, pattern string,
+	message ...any,
+) error {
+	t.Helper()
+
+	re := regexp.MustCompile(pattern)
+
+	content, err := readFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageFileError, name, err)
+	if err == nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageFileMatches, name, pattern)
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && re.Match(content) },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryFileSize tries to test whether the size of the file is the expected size, and it'll fail if
+// the file does not exist or the size is not the expected size.
+func tryFileSize(
+	t testingT,
+	failedNow bool,
+	fsys fs.FS,
+	name string,
+	size int64,
+	message ...any,
+) error {
+	t.Helper()
+
+	info, err := statFile(fsys, name)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageFileError, name, err)
+	if err == nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageFileSize, name, size, info.Size())
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && info.Size() == size },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// statFile returns the file info of the file in the file system, or the OS file system if fsys is
+// nil. It returns an error that wraps fs.ErrInvalid if the name is not a valid path of the file system.
+func statFile(fsys fs.FS, name string) (fs.FileInfo, error) {
+	if fsys == nil {
+		return os.Stat(name)
+	}
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return fs.Stat(fsys, name)
+}
+
+// readFile reads the content of the file in the file system, or the OS file system if fsys is nil.
+func readFile(fsys fs.FS, name string) ([]byte, error) {
+	if fsys == nil {
+		return os.ReadFile(name)
+	}
+
+	return fs.ReadFile(fsys, name)
+}
+
+// getFileTypeDescription describes the type of the file by the file info or the error of getting
+// the file info.
+func getFileTypeDescription(info fs.FileInfo, err error) string {
+	switch {
+	case err != nil:
+		return fmt.Sprintf("got error: %v", err)
+	case info.IsDir():
+		return "got a directory"
+	default:
+		return fmt.Sprintf("got a file with mode %v", info.Mode())
+	}
+}
+
+// formatContentDiff formats the difference between the expected and the actual contents. It
+// returns a line-based diff for the texts, and the sizes for the binary contents.
+func formatContentDiff(expected, actual []byte) string {
+	if isBinaryContent(expected) || isBinaryContent(actual) {
+		return fmt.Sprintf(
+			"binary contents differ, expected %d bytes, got %d bytes",
+			len(expected), len(actual),
+		)
+	}
+
+	return formatTextDiff(string(expected), string(actual))
+}
+
+// isBinaryContent checks whether the content is not a valid UTF-8 text or contains NUL bytes.
+func isBinaryContent(content []byte) bool {
+	return !utf8.Valid(content) || bytes.IndexByte(content, 0) >= 0
+}
@@ -0,0 +1,383 @@
+package assert
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func newTestFS() fstest.MapFS {
+	return fstest.MapFS{
+		"out.txt":     {Data: []byte("Hello world\n"), Mode: 0644},
+		"bin.dat":     {Data: []byte{0, 1, 2}, Mode: 0600},
+		"dir/sub.txt": {Data: []byte("line1\nline2\nline3\n"), Mode: 0755},
+	}
+}
+
+func newTestOSDir(a *Assertion) string {
+	a.Helper()
+
+	dir := a.TempDir()
+	a.NilNow(os.WriteFile(filepath.Join(dir, "out.txt"), []byte("Hello world\n"), 0644))
+	a.NilNow(os.Chmod(filepath.Join(dir, "out.txt"), 0644))
+	a.NilNow(os.Mkdir(filepath.Join(dir, "dir"), 0755))
+	a.NilNow(os.Chmod(filepath.Join(dir, "dir"), 0755))
+
+	return dir
+}
+
+func TestFileExistsAndNoFileExists(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileExistsAndNoFileExists(a, mockA, fsys, "out.txt", true)
+	testFileExistsAndNoFileExists(a, mockA, fsys, "dir/sub.txt", true)
+	testFileExistsAndNoFileExists(a, mockA, fsys, "dir", false)
+	testFileExistsAndNoFileExists(a, mockA, fsys, "not-exist.txt", false)
+	testFileExistsAndNoFileExists(a, mockA, nil, filepath.Join(dir, "out.txt"), true)
+	testFileExistsAndNoFileExists(a, mockA, nil, filepath.Join(dir, "dir"), false)
+	testFileExistsAndNoFileExists(a, mockA, nil, filepath.Join(dir, "not-exist.txt"), false)
+	testFileExistsAndNoFileExists(a, mockA, os.DirFS(dir), "out.txt", true)
+
+	err := mockA.FileExists(fsys, "dir")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect file "dir" exists, got a directory`)
+}
+
+func testFileExistsAndNoFileExists(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name string,
+	isExist bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileExists", func() error {
+		return FileExists(mockA.T, fsys, name)
+	}, isExist)
+	testAssertionFunction(a, "Assertion.FileExists", func() error {
+		return mockA.FileExists(fsys, name)
+	}, isExist)
+	testAssertionNowFunction(a, "FileExistsNow", func() {
+		FileExistsNow(mockA.T, fsys, name)
+	}, !isExist)
+	testAssertionNowFunction(a, "Assertion.FileExistsNow", func() {
+		mockA.FileExistsNow(fsys, name)
+	}, !isExist)
+
+	testAssertionFunction(a, "NoFileExists", func() error {
+		return NoFileExists(mockA.T, fsys, name)
+	}, !isExist)
+	testAssertionFunction(a, "Assertion.NoFileExists", func() error {
+		return mockA.NoFileExists(fsys, name)
+	}, !isExist)
+	testAssertionNowFunction(a, "NoFileExistsNow", func() {
+		NoFileExistsNow(mockA.T, fsys, name)
+	}, isExist)
+	testAssertionNowFunction(a, "Assertion.NoFileExistsNow", func() {
+		mockA.NoFileExistsNow(fsys, name)
+	}, isExist)
+}
+
+type testErrorFS struct {
+	err error
+}
+
+func (fsys testErrorFS) Open(name string) (fs.File, error) {
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fsys.err}
+}
+
+func TestNoFileExistsWithInaccessibleFile(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+
+	testNoFileExists(a, mockA, fsys, "/out.txt", false)
+	testNoFileExists(a, mockA, fsys, "../out.txt", false)
+	testNoFileExists(a, mockA, fsys, "dir/../out.txt", false)
+	testNoFileExists(a, mockA, testErrorFS{err: fs.ErrPermission}, "out.txt", false)
+	testNoFileExists(a, mockA, testErrorFS{err: fs.ErrNotExist}, "out.txt", true)
+
+	err := mockA.NoFileExists(fsys, "/out.txt")
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		`assert error: expect file "/out.txt" accessible, got error: stat /out.txt: invalid argument`,
+	)
+
+	err = mockA.NoFileExists(fsys, "out.txt")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect file "out.txt" not exists`)
+}
+
+func testNoFileExists(a, mockA *Assertion, fsys fs.FS, name string, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "NoFileExists", func() error {
+		return NoFileExists(mockA.T, fsys, name)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.NoFileExists", func() error {
+		return mockA.NoFileExists(fsys, name)
+	}, isOk)
+	testAssertionNowFunction(a, "NoFileExistsNow", func() {
+		NoFileExistsNow(mockA.T, fsys, name)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.NoFileExistsNow", func() {
+		mockA.NoFileExistsNow(fsys, name)
+	}, !isOk)
+}
+
+func TestDirExists(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testDirExists(a, mockA, fsys, "dir", true)
+	testDirExists(a, mockA, fsys, ".", true)
+	testDirExists(a, mockA, fsys, "out.txt", false)
+	testDirExists(a, mockA, fsys, "not-exist", false)
+	testDirExists(a, mockA, nil, dir, true)
+	testDirExists(a, mockA, nil, filepath.Join(dir, "dir"), true)
+	testDirExists(a, mockA, nil, filepath.Join(dir, "out.txt"), false)
+	testDirExists(a, mockA, nil, filepath.Join(dir, "not-exist"), false)
+}
+
+func testDirExists(a, mockA *Assertion, fsys fs.FS, name string, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "DirExists", func() error {
+		return DirExists(mockA.T, fsys, name)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.DirExists", func() error {
+		return mockA.DirExists(fsys, name)
+	}, isOk)
+	testAssertionNowFunction(a, "DirExistsNow", func() {
+		DirExistsNow(mockA.T, fsys, name)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.DirExistsNow", func() {
+		mockA.DirExistsNow(fsys, name)
+	}, !isOk)
+}
+
+func TestFileMode(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileMode(a, mockA, fsys, "out.txt", 0644, true)
+	testFileMode(a, mockA, fsys, "bin.dat", 0600, true)
+	testFileMode(a, mockA, fsys, "out.txt", 0755, false)
+	testFileMode(a, mockA, fsys, "not-exist.txt", 0644, false)
+	testFileMode(a, mockA, nil, filepath.Join(dir, "dir"), 0755, false)
+	// Windows reports the permission bits of the files as 0666 or 0777.
+	if runtime.GOOS != "windows" {
+		testFileMode(a, mockA, nil, filepath.Join(dir, "out.txt"), 0644, true)
+		testFileMode(a, mockA, nil, filepath.Join(dir, "dir"), fs.ModeDir|0755, true)
+	}
+
+	err := mockA.FileMode(fsys, "not-exist.txt", 0644)
+	a.NotNilNow(err)
+	a.TrueNow(strings.HasPrefix(
+		err.Error(),
+		`assert error: expect file "not-exist.txt" accessible, got error: `,
+	))
+}
+
+func testFileMode(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name string,
+	mode fs.FileMode,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileMode", func() error {
+		return FileMode(mockA.T, fsys, name, mode)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.FileMode", func() error {
+		return mockA.FileMode(fsys, name, mode)
+	}, isOk)
+	testAssertionNowFunction(a, "FileModeNow", func() {
+		FileModeNow(mockA.T, fsys, name, mode)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.FileModeNow", func() {
+		mockA.FileModeNow(fsys, name, mode)
+	}, !isOk)
+}
+
+func TestFileContentEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileContentEqual(a, mockA, fsys, "out.txt", "Hello world\n", true)
+	testFileContentEqual(a, mockA, fsys, "out.txt", "Hello world", false)
+	testFileContentEqual(a, mockA, fsys, "bin.dat", "\x00\x01\x02", true)
+	testFileContentEqual(a, mockA, fsys, "bin.dat", "\x00\x01", false)
+	testFileContentEqual(a, mockA, fsys, "not-exist.txt", "", false)
+	testFileContentEqual(a, mockA, nil, filepath.Join(dir, "out.txt"), "Hello world\n", true)
+	testFileContentEqual(a, mockA, nil, filepath.Join(dir, "dir"), "", false)
+
+	err := mockA.FileContentEqual(fsys, "dir/sub.txt", "line1\nline3\nline4\n")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect file "dir/sub.txt" content equals:
+
+--- expected
++++ actual
+@@ -1,3 +1,3 @@
+1 1  line1
+  2 +line2
+2 3  line3
+3   -line4`)
+
+	err = mockA.FileContentEqual(fsys, "bin.dat", "\x00\x01")
+	a.NotNilNow(err)
+	a.TrueNow(strings.HasSuffix(
+		err.Error(),
+		"binary contents differ, expected 2 bytes, got 3 bytes",
+	))
+}
+
+func testFileContentEqual(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name, expected string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileContentEqual", func() error {
+		return FileContentEqual(mockA.T, fsys, name, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.FileContentEqual", func() error {
+		return mockA.FileContentEqual(fsys, name, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "FileContentEqualNow", func() {
+		FileContentEqualNow(mockA.T, fsys, name, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.FileContentEqualNow", func() {
+		mockA.FileContentEqualNow(fsys, name, expected)
+	}, !isOk)
+}
+
+func TestFileContains(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileContains(a, mockA, fsys, "out.txt", "Hello", true)
+	testFileContains(a, mockA, fsys, "out.txt", "", true)
+	testFileContains(a, mockA, fsys, "out.txt", "Goodbye", false)
+	testFileContains(a, mockA, fsys, "not-exist.txt", "", false)
+	testFileContains(a, mockA, nil, filepath.Join(dir, "out.txt"), "world", true)
+	testFileContains(a, mockA, nil, filepath.Join(dir, "out.txt"), "World", false)
+}
+
+func testFileContains(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name, substr string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileContains", func() error {
+		return FileContains(mockA.T, fsys, name, substr)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.FileContains", func() error {
+		return mockA.FileContains(fsys, name, substr)
+	}, isOk)
+	testAssertionNowFunction(a, "FileContainsNow", func() {
+		FileContainsNow(mockA.T, fsys, name, substr)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.FileContainsNow", func() {
+		mockA.FileContainsNow(fsys, name, substr)
+	}, !isOk)
+}
+
+func TestFileMatches(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileMatches(a, mockA, fsys, "out.txt", `^Hello \w+\n$`, true)
+	testFileMatches(a, mockA, fsys, "dir/sub.txt", `(?m)^line2$`, true)
+	testFileMatches(a, mockA, fsys, "out.txt", `^Goodbye`, false)
+	testFileMatches(a, mockA, fsys, "not-exist.txt", `.*`, false)
+	testFileMatches(a, mockA, nil, filepath.Join(dir, "out.txt"), `world`, true)
+
+	a.PanicNow(func() {
+		mockA.FileMatches(fsys, "out.txt", `[`)
+	})
+}
+
+func testFileMatches(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name, pattern string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileMatches", func() error {
+		return FileMatches(mockA.T, fsys, name, pattern)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.FileMatches", func() error {
+		return mockA.FileMatches(fsys, name, pattern)
+	}, isOk)
+	testAssertionNowFunction(a, "FileMatchesNow", func() {
+		FileMatchesNow(mockA.T, fsys, name, pattern)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.FileMatchesNow", func() {
+		mockA.FileMatchesNow(fsys, name, pattern)
+	}, !isOk)
+}
+
+func TestFileSize(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	fsys := newTestFS()
+	dir := newTestOSDir(a)
+
+	testFileSize(a, mockA, fsys, "out.txt", 12, true)
+	testFileSize(a, mockA, fsys, "bin.dat", 3, true)
+	testFileSize(a, mockA, fsys, "out.txt", 0, false)
+	testFileSize(a, mockA, fsys, "not-exist.txt", 0, false)
+	testFileSize(a, mockA, nil, filepath.Join(dir, "out.txt"), 12, true)
+	testFileSize(a, mockA, nil, filepath.Join(dir, "out.txt"), 11, false)
+}
+
+func testFileSize(
+	a, mockA *Assertion,
+	fsys fs.FS,
+	name string,
+	size int64,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "FileSize", func() error {
+		return FileSize(mockA.T, fsys, name, size)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.FileSize", func() error {
+		return mockA.FileSize(fsys, name, size)
+	}, isOk)
+	testAssertionNowFunction(a, "FileSizeNow", func() {
+		FileSizeNow(mockA.T, fsys, name, size)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.FileSizeNow", func() {
+		mockA.FileSizeNow(fsys, name, size)
+	}, !isOk)
+}
@@ -0,0 +1,224 @@
+package assert
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// goroutineSafeState is the state of a test in the goroutine-safe mode.
+type goroutineSafeState struct {
+	// goroutineID is the id of the test goroutine.
+	goroutineID int
+	// wg is the wait group of the goroutines that started by the Go method.
+	wg sync.WaitGroup
+	// mu is the lock of the errors and the termination flag.
+	mu sync.Mutex
+	// errs are the assertion errors from the worker goroutines that are not reported yet.
+	errs []error
+	// isTerminated indicates whether any worker goroutine is terminated by a XXXNow assertion.
+	isTerminated bool
+}
+
+var (
+	// goroutineSafeStates are the states of the tests in the goroutine-safe mode.
+	goroutineSafeStates = make(map[*testing.T]*goroutineSafeState)
+	// goroutineSafeStatesMu is the lock of the goroutineSafeStates.
+	goroutineSafeStatesMu sync.RWMutex
+	// goroutineSafeStatesCount is the number of the goroutineSafeStates, it's used to skip the
+	// lookup of the states if no test is in the goroutine-safe mode.
+	goroutineSafeStatesCount int32
+)
+
+// GoroutineSafe enables the goroutine-safe mode of the test, and returns the assertion instance
+// itself. It must be called from the test goroutine.
+//
+// In the goroutine-safe mode, the failures of the assertions that are called from other
+// goroutines will not be reported immediately, they will be reported by the test goroutine when
+// calling the Wait method or after the test completed. The XXXNow assertions only terminate the
+// worker goroutines, and the test will be terminated by the Wait method. The Wait method also
+// disables the goroutine-safe mode, so it should be called after all the worker goroutines have
+// completed, and the GoroutineSafe method should be called again to assert in new goroutines.
+//
+//	a := assert.New(t).GoroutineSafe()
+//	wg := sync.WaitGroup{}
+//	wg.Add(1)
+//	go func() {
+//	  defer wg.Done()
+//	  a.EqualNow(1, 2) // fail and terminate the goroutine
+//	  // never runs
+//	}()
+//	wg.Wait()
+//	a.Wait() // report the failures and terminate
+//	// never runs
+func (a *Assertion) GoroutineSafe() *Assertion {
+	getOrEnableGoroutineSafe(a.T)
+
+	return a
+}
+
+// Go runs the function in a new goroutine with the goroutine-safe mode enabled, and the function
+// receives the assertion instance to assert in the goroutine. The panics in the function will be
+// recovered and reported as failures. It must be called from the test goroutine, and the Wait
+// method should be called to wait for the goroutines and report the failures.
+//
+//	a := assert.New(t)
+//	for i := 0; i < 3; i++ {
+//	  a.Go(func(a *assert.Assertion) {
+//	    a.NotNilNow(fetch(i))
+//	    // ...
+//	  })
+//	}
+//	a.Wait()
+func (a *Assertion) Go(fn func(a *Assertion)) {
+	state := getOrEnableGoroutineSafe(a.T)
+
+	state.wg.Add(1)
+
+	go func() {
+		defer state.wg.Done()
+		defer func() {
+			if e := recover(); e != nil {
+				state.report(newAssertionError(fmt.Sprintf(
+					defaultErrMessageUnexpectedPanic, formatPanicValue(e), debug.Stack(),
+				)), false)
+			}
+		}()
+
+		fn(a)
+	}()
+}
+
+// Wait waits for all the goroutines that started by the Go method to complete, and reports the
+// failures of the assertions that are called from other goroutines. It'll terminate the execution
+// if any goroutine is terminated by a XXXNow assertion. It must be called from the test goroutine,
+// and it disables the goroutine-safe mode after all the goroutines completed.
+//
+//	a := assert.New(t)
+//	a.Go(func(a *assert.Assertion) {
+//	  a.EqualNow(1, 2) // fail and terminate the goroutine
+//	})
+//	a.Wait() // terminate
+//	// never runs
+func (a *Assertion) Wait() {
+	a.Helper()
+
+	goroutineSafeStatesMu.RLock()
+	state := goroutineSafeStates[a.T]
+	goroutineSafeStatesMu.RUnlock()
+
+	if state == nil {
+		return
+	}
+
+	state.wg.Wait()
+	disableGoroutineSafe(a.T, state)
+
+	if state.flush(a.T) {
+		a.FailNow()
+	}
+}
+
+// getOrEnableGoroutineSafe returns the goroutine-safe state of the test, and it'll enable the
+// goroutine-safe mode if it is not enabled.
+func getOrEnableGoroutineSafe(t *testing.T) *goroutineSafeState {
+	goroutineSafeStatesMu.Lock()
+	defer goroutineSafeStatesMu.Unlock()
+
+	if state, ok := goroutineSafeStates[t]; ok {
+		return state
+	}
+
+	state := &goroutineSafeState{
+		goroutineID: getGoroutineID(),
+		errs:        make([]error, 0),
+	}
+	goroutineSafeStates[t] = state
+	atomic.AddInt32(&goroutineSafeStatesCount, 1)
+
+	t.Cleanup(func() {
+		disableGoroutineSafe(t, state)
+		state.flush(t)
+	})
+
+	return state
+}
+
+// disableGoroutineSafe disables the goroutine-safe mode of the test if the state is the current
+// goroutine-safe state of the test.
+func disableGoroutineSafe(t *testing.T, state *goroutineSafeState) {
+	goroutineSafeStatesMu.Lock()
+	defer goroutineSafeStatesMu.Unlock()
+
+	if goroutineSafeStates[t] == state {
+		delete(goroutineSafeStates, t)
+		atomic.AddInt32(&goroutineSafeStatesCount, -1)
+	}
+}
+
+// getWorkerGoroutineSafeState returns the goroutine-safe state of the test if the goroutine-safe
+// mode is enabled and the current goroutine is not the test goroutine, otherwise it returns nil.
+func getWorkerGoroutineSafeState(t *testing.T) *goroutineSafeState {
+	if atomic.LoadInt32(&goroutineSafeStatesCount) == 0 {
+		return nil
+	}
+
+	goroutineSafeStatesMu.RLock()
+	state := goroutineSafeStates[t]
+	goroutineSafeStatesMu.RUnlock()
+
+	if state == nil || state.goroutineID == getGoroutineID() {
+		return nil
+	}
+
+	return state
+}
+
+// report records the assertion error from the worker goroutine.
+func (state *goroutineSafeState) report(err error, failedNow bool) {
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	state.errs = append(state.errs, err)
+	if failedNow {
+		state.isTerminated = true
+	}
+}
+
+// flush reports the recorded errors to the test, and returns whether any worker goroutine is
+// terminated by a XXXNow assertion. The recorded errors and the termination flag will be reset.
+func (state *goroutineSafeState) flush(t *testing.T) bool {
+	t.Helper()
+
+	state.mu.Lock()
+	errs := state.errs
+	isTerminated := state.isTerminated
+	state.errs = make([]error, 0)
+	state.isTerminated = false
+	state.mu.Unlock()
+
+	for _, err := range errs {
+		t.Error(err)
+	}
+
+	return isTerminated
+}
+
+// getGoroutineID returns the id of the current goroutine.
+func getGoroutineID() int {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+
+	matches := goroutineHeaderPattern.FindSubmatch(buf)
+	if matches == nil {
+		return 0
+	}
+
+	id, _ := strconv.Atoi(string(matches[1]))
+
+	return id
+}
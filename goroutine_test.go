@@ -0,0 +1,177 @@
+package assert
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ghosind/go-assert/internal"
+)
+
+func TestGoroutineSafe(t *testing.T) {
+	a := New(t)
+
+	mockT := new(testing.T)
+	mockA := New(mockT)
+	a.EqualNow(mockA.GoroutineSafe(), mockA)
+
+	isRun := false
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		mockA.EqualNow(1, 2)
+		isRun = true
+	}()
+	wg.Wait()
+
+	a.NotTrueNow(isRun)
+	a.NotTrueNow(mockT.Failed())
+	a.TrueNow(internal.CheckTermination(func() {
+		mockA.Wait()
+	}))
+	a.TrueNow(mockT.Failed())
+	a.NilNow(getWorkerGoroutineSafeState(mockT))
+
+	// failures in the test goroutine are reported immediately
+	mockT = new(testing.T)
+	mockA = New(mockT).GoroutineSafe()
+	a.NotNilNow(mockA.Equal(1, 2))
+	a.TrueNow(mockT.Failed())
+	mockA.Wait()
+}
+
+func TestGoroutineSafeStatesRelease(t *testing.T) {
+	a := New(t)
+
+	count := atomic.LoadInt32(&goroutineSafeStatesCount)
+
+	mockT := new(testing.T)
+	mockA := New(mockT).GoroutineSafe()
+	a.EqualNow(atomic.LoadInt32(&goroutineSafeStatesCount), count+1)
+
+	mockA.Wait()
+	a.EqualNow(atomic.LoadInt32(&goroutineSafeStatesCount), count)
+	goroutineSafeStatesMu.RLock()
+	_, ok := goroutineSafeStates[mockT]
+	goroutineSafeStatesMu.RUnlock()
+	a.NotTrueNow(ok)
+
+	// enables the goroutine-safe mode again after Wait
+	mockA.Go(func(a *Assertion) {
+		a.Equal(1, 2)
+	})
+	mockA.Wait()
+	a.TrueNow(mockT.Failed())
+	a.EqualNow(atomic.LoadInt32(&goroutineSafeStatesCount), count)
+
+	// the state is released by the cleanup if Wait is never called
+	t.Run("cleanup", func(t *testing.T) {
+		New(t).GoroutineSafe()
+		a.EqualNow(atomic.LoadInt32(&goroutineSafeStatesCount), count+1)
+	})
+	a.EqualNow(atomic.LoadInt32(&goroutineSafeStatesCount), count)
+}
+
+func TestGo(t *testing.T) {
+	a := New(t)
+
+	testGo(a, func(a *Assertion) {
+		a.Equal(1, 1)
+	}, true, false, false)
+	testGo(a, func(a *Assertion) {
+		a.Equal(1, 2)
+	}, true, true, false)
+	testGo(a, func(a *Assertion) {
+		Equal(a.T, 1, 2)
+	}, true, true, false)
+	testGo(a, func(a *Assertion) {
+		a.EqualNow(1, 2)
+	}, false, true, true)
+	testGo(a, func(a *Assertion) {
+		EqualNow(a.T, 1, 2)
+	}, false, true, true)
+	testGo(a, func(a *Assertion) {
+		panic("unexpected panic")
+	}, false, true, false)
+}
+
+func testGo(a *Assertion, fn func(a *Assertion), isCompleted, isFailed, isTerminated bool) {
+	a.Helper()
+
+	mockT := new(testing.T)
+	mockA := New(mockT)
+
+	isRun := make([]bool, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		mockA.Go(func(a *Assertion) {
+			fn(a)
+			isRun[i] = true
+		})
+	}
+
+	a.EqualNow(internal.CheckTermination(func() {
+		mockA.Wait()
+	}), isTerminated)
+	a.EqualNow(mockT.Failed(), isFailed)
+	for _, ok := range isRun {
+		a.EqualNow(ok, isCompleted)
+	}
+}
+
+func TestGoroutineSafeReportedErrors(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	mockA.Go(func(a *Assertion) {
+		a.Equal(1, 2)
+	})
+	mockA.Go(func(a *Assertion) {
+		panic("unexpected panic")
+	})
+	mockA.Go(func(a *Assertion) {
+		a.EqualNow(1, 2)
+	})
+
+	state := getOrEnableGoroutineSafe(mockA.T)
+	state.wg.Wait()
+
+	a.EqualNow(len(state.errs), 3)
+	a.TrueNow(state.isTerminated)
+
+	messages := make([]string, 0, len(state.errs))
+	for _, err := range state.errs {
+		messages = append(messages, err.Error())
+	}
+	a.TrueNow(strings.Contains(strings.Join(messages, "\n"), "got unexpected panic"))
+
+	a.TrueNow(state.flush(mockA.T))
+	a.EqualNow(len(state.errs), 0)
+	a.NotTrueNow(state.isTerminated)
+	a.NotTrueNow(state.flush(mockA.T))
+
+	disableGoroutineSafe(mockA.T, state)
+	a.NilNow(getWorkerGoroutineSafeState(mockA.T))
+}
+
+func TestGetGoroutineID(t *testing.T) {
+	a := New(t)
+
+	id := getGoroutineID()
+	a.NotEqualNow(id, 0)
+	a.EqualNow(getGoroutineID(), id)
+
+	var otherID int
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		otherID = getGoroutineID()
+	}()
+	wg.Wait()
+
+	a.NotEqualNow(otherID, 0)
+	a.NotEqualNow(otherID, id)
+}
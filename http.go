@@ -0,0 +1,478 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// maxHTTPBodySummaryLength is the maximum length of the response body in the response summary.
+const maxHTTPBodySummaryLength = 1024
+
+// HTTPStatus executes the handler with a request of the method, the url, and the body, and tests
+// whether the status code of the response is the expected code. It'll set the result to fail with
+// the response summary if the status code is not the expected code. The body can be nil if the
+// request has no body.
+//
+//	a := assert.New(t)
+//	a.HTTPStatus(handler, http.MethodGet, "/users/1", nil, http.StatusOK) // success
+//	a.HTTPStatus(handler, http.MethodGet, "/not-found", nil, http.StatusOK) // fail
+func (a *Assertion) HTTPStatus(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	code int,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPStatus(a.testingT(), false, handler, method, rawURL, body, code, message...)
+}
+
+// HTTPStatusNow executes the handler with a request of the method, the url, and the body, and tests
+// whether the status code of the response is the expected code. It'll terminate the execution if
+// the status code is not the expected code.
+//
+//	a := assert.New(t)
+//	a.HTTPStatusNow(handler, http.MethodGet, "/users/1", nil, http.StatusOK) // success
+//	a.HTTPStatusNow(handler, http.MethodGet, "/not-found", nil, http.StatusOK) // fail and terminate
+//	// never runs
+func (a *Assertion) HTTPStatusNow(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	code int,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPStatus(a.testingT(), true, handler, method, rawURL, body, code, message...)
+}
+
+// HTTPHeader executes the handler with a request of the method, the url, and the body, and tests
+// whether the response has the header with the expected value. It'll set the result to fail with
+// the response summary if no value of the header is the expected value.
+//
+//	a := assert.New(t)
+//	a.HTTPHeader(handler, http.MethodGet, "/", nil, "Content-Type", "text/plain") // success
+//	a.HTTPHeader(handler, http.MethodGet, "/", nil, "Content-Type", "text/html") // fail
+func (a *Assertion) HTTPHeader(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	key, value string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPHeader(a.testingT(), false, handler, method, rawURL, body, key, value, message...)
+}
+
+// HTTPHeaderNow executes the handler with a request of the method, the url, and the body, and tests
+// whether the response has the header with the expected value. It'll terminate the execution if
+// no value of the header is the expected value.
+//
+//	a := assert.New(t)
+//	a.HTTPHeaderNow(handler, http.MethodGet, "/", nil, "Content-Type", "text/plain") // success
+//	a.HTTPHeaderNow(handler, http.MethodGet, "/", nil, "Content-Type", "text/html") // fail and terminate
+//	// never runs
+func (a *Assertion) HTTPHeaderNow(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	key, value string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPHeader(a.testingT(), true, handler, method, rawURL, body, key, value, message...)
+}
+
+// HTTPBodyContains executes the handler with a request of the method, the url, and the body, and
+// tests whether the body of the response contains the substring. It'll set the result to fail with
+// the response summary if the body does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.HTTPBodyContains(handler, http.MethodGet, "/", nil, "Hello") // success
+//	a.HTTPBodyContains(handler, http.MethodGet, "/", nil, "Goodbye") // fail
+func (a *Assertion) HTTPBodyContains(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	substr string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPBodyContains(
+		a.testingT(), false, handler, method, rawURL, body, substr, message...,
+	)
+}
+
+// HTTPBodyContainsNow executes the handler with a request of the method, the url, and the body,
+// and tests whether the body of the response contains the substring. It'll terminate the execution
+// if the body does not contain the substring.
+//
+//	a := assert.New(t)
+//	a.HTTPBodyContainsNow(handler, http.MethodGet, "/", nil, "Hello") // success
+//	a.HTTPBodyContainsNow(handler, http.MethodGet, "/", nil, "Goodbye") // fail and terminate
+//	// never runs
+func (a *Assertion) HTTPBodyContainsNow(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	substr string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPBodyContains(
+		a.testingT(), true, handler, method, rawURL, body, substr, message...,
+	)
+}
+
+// HTTPBodyJSONEqual executes the handler with a request of the method, the url, and the body, and
+// tests whether the body of the response is a JSON that equals to the expected value. The expected
+// value can be a JSON string, a JSON byte slice, or any value that can be encoded to JSON. It'll
+// set the result to fail with the response summary if the body is not the same JSON as the
+// expected value, and the order of the object keys and the whitespaces are ignored.
+//
+//	a := assert.New(t)
+//	a.HTTPBodyJSONEqual(handler, http.MethodGet, "/users/1", nil, `{"id":1}`) // success
+//	a.HTTPBodyJSONEqual(handler, http.MethodGet, "/users/1", nil, map[string]int{"id": 1}) // success
+//	a.HTTPBodyJSONEqual(handler, http.MethodGet, "/users/1", nil, `{"id":2}`) // fail
+func (a *Assertion) HTTPBodyJSONEqual(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	expected any,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPBodyJSONEqual(
+		a.testingT(), false, handler, method, rawURL, body, expected, message...,
+	)
+}
+
+// HTTPBodyJSONEqualNow executes the handler with a request of the method, the url, and the body,
+// and tests whether the body of the response is a JSON that equals to the expected value. It'll
+// terminate the execution if the body is not the same JSON as the expected value.
+//
+//	a := assert.New(t)
+//	a.HTTPBodyJSONEqualNow(handler, http.MethodGet, "/users/1", nil, `{"id":1}`) // success
+//	a.HTTPBodyJSONEqualNow(handler, http.MethodGet, "/users/1", nil, `{"id":2}`) // fail and terminate
+//	// never runs
+func (a *Assertion) HTTPBodyJSONEqualNow(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	expected any,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPBodyJSONEqual(
+		a.testingT(), true, handler, method, rawURL, body, expected, message...,
+	)
+}
+
+// HTTPRedirectsTo executes the handler with a request of the method, the url, and the body, and
+// tests whether the response is a redirection to the expected location. It'll set the result to
+// fail with the response summary if the status code is not 3xx, or the location is not the
+// expected location. The relative locations are resolved by the request url before comparing.
+//
+//	a := assert.New(t)
+//	a.HTTPRedirectsTo(handler, http.MethodGet, "/old", nil, "/new") // success
+//	a.HTTPRedirectsTo(handler, http.MethodGet, "/new", nil, "/old") // fail
+func (a *Assertion) HTTPRedirectsTo(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	location string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPRedirectsTo(
+		a.testingT(), false, handler, method, rawURL, body, location, message...,
+	)
+}
+
+// HTTPRedirectsToNow executes the handler with a request of the method, the url, and the body, and
+// tests whether the response is a redirection to the expected location. It'll terminate the
+// execution if the status code is not 3xx, or the location is not the expected location.
+//
+//	a := assert.New(t)
+//	a.HTTPRedirectsToNow(handler, http.MethodGet, "/old", nil, "/new") // success
+//	a.HTTPRedirectsToNow(handler, http.MethodGet, "/new", nil, "/old") // fail and terminate
+//	// never runs
+func (a *Assertion) HTTPRedirectsToNow(
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	location string,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryHTTPRedirectsTo(
+		a.testingT(), true, handler, method, rawURL, body, location, message...,
+	)
+}
+
+// tryHTTPStatus tries to execute the handler, and it'll fail if the status code of the response is
+// not the expected code.
+func tryHTTPStatus(
+	t testingT,
+	failedNow bool,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	code int,
+	message ...any,
+) error {
+	t.Helper()
+
+	resp, respBody := executeHTTPHandler(handler, method, rawURL, body)
+
+	return test(
+		t,
+		func() bool { return resp.StatusCode == code },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageHTTPStatus,
+			code, resp.StatusCode, formatHTTPResponse(resp, respBody),
+		),
+		message...,
+	)
+}
+
+// tryHTTPHeader tries to execute the handler, and it'll fail if no value of the header in the
+// response is the expected value.
+func tryHTTPHeader(
+	t testingT,
+	failedNow bool,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	key, value string,
+	message ...any,
+) error {
+	t.Helper()
+
+	resp, respBody := executeHTTPHandler(handler, method, rawURL, body)
+	values := resp.Header.Values(key)
+
+	return test(
+		t,
+		func() bool {
+			for _, v := range values {
+				if v == value {
+					return true
+				}
+			}
+			return false
+		},
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageHTTPHeader,
+			http.CanonicalHeaderKey(key), value, values, formatHTTPResponse(resp, respBody),
+		),
+		message...,
+	)
+}
+
+// tryHTTPBodyContains tries to execute the handler, and it'll fail if the body of the response does
+// not contain the substring.
+func tryHTTPBodyContains(
+	t testingT,
+	failedNow bool,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	resp, respBody := executeHTTPHandler(handler, method, rawURL, body)
+
+	return test(
+		t,
+		func() bool { return bytes.Contains(respBody, []byte(substr)) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageHTTPBodyContains, substr, formatHTTPResponse(resp, respBody)),
+		message...,
+	)
+}
+
+// tryHTTPBodyJSONEqual tries to execute the handler, and it'll fail if the body of the response is
+// not the same JSON as the expected value.
+func tryHTTPBodyJSONEqual(
+	t testingT,
+	failedNow bool,
+	handler http.Handler,
+	method, rawURL string,
+	body io.Reader,
+	expected any,
+	message ...any,
+) error {
+	t.Helper()
+
+	resp, respBody := executeHTTPHandler(handler, method, rawURL, body)
+	expectedJSON := toJSON(expected)
+
+	return test(
+		t,
+		func() bool { return isJSONEqual(respBody, expectedJSON) },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageHTTPBodyJSONEqual,
+			expectedJSON, formatHTTPResponse(resp, respBody),
+		),
+		message...,
+	)
+}
+
+// tryHTTPRedirectsTo tries to execute the handler, and it'll fail if the response is not a
+// redirection to the expected location.
+func tryHTTPRedirectsTo(
+	t testingT,
+	failedNow bool,
+	handler http.Handler,
+	method, target string,
+	body io.Reader,
+	location string,
+	message ...any,
+) error {
+	t.Helper()
+
+	resp, respBody := executeHTTPHandler(handler, method, target, body)
+	actual := resp.Header.Get("Location")
+
+	base := *resp.Request.URL
+	if base.Host == "" {
+		base.Scheme = "http"
+		base.Host = resp.Request.Host
+	}
+
+	return test(
+		t,
+		func() bool {
+			if resp.StatusCode < 300 || resp.StatusCode >= 400 || actual == "" {
+				return false
+			}
+			return resolveURL(&base, actual) == resolveURL(&base, location)
+		},
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageHTTPRedirectsTo,
+			location, resp.StatusCode, actual, formatHTTPResponse(resp, respBody),
+		),
+		message...,
+	)
+}
+
+// executeHTTPHandler executes the handler with a new request, and returns the response with the
+// body of the response.
+func executeHTTPHandler(
+	handler http.Handler,
+	method, target string,
+	body io.Reader,
+) (*http.Response, []byte) {
+	req := httptest.NewRequest(method, target, body)
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	resp := recorder.Result()
+	resp.Request = req
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	return resp, respBody
+}
+
+// formatHTTPResponse formats the response with the status line, the sorted headers, and the body.
+// The body will be truncated if it is too long.
+func formatHTTPResponse(resp *http.Response, body []byte) string {
+	builder := strings.Builder{}
+
+	builder.WriteString(fmt.Sprintf("%s %s\n", resp.Proto, resp.Status))
+
+	keys := make([]string, 0, len(resp.Header))
+	for key := range resp.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range resp.Header[key] {
+			builder.WriteString(fmt.Sprintf("%s: %s\n", key, value))
+		}
+	}
+
+	builder.WriteString("\n")
+	if len(body) > maxHTTPBodySummaryLength {
+		builder.Write(body[:maxHTTPBodySummaryLength])
+		builder.WriteString(
+			fmt.Sprintf("... (%d bytes truncated)", len(body)-maxHTTPBodySummaryLength),
+		)
+	} else {
+		builder.Write(body)
+	}
+
+	return builder.String()
+}
+
+// toJSON converts the value to JSON. The strings, the byte slices, and the `json.RawMessage` are
+// treated as JSON texts, and other values will be encoded to JSON.
+func toJSON(v any) []byte {
+	switch val := v.(type) {
+	case string:
+		return []byte(val)
+	case []byte:
+		return val
+	case json.RawMessage:
+		return val
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+// isJSONEqual checks whether the JSON texts represent the same value.
+func isJSONEqual(x, y []byte) bool {
+	var xv, yv any
+
+	if err := json.Unmarshal(x, &xv); err != nil {
+		return false
+	}
+	if err := json.Unmarshal(y, &yv); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(xv, yv)
+}
+
+// resolveURL resolves the reference by the base url, and returns the reference itself if it is
+// not a valid url.
+func resolveURL(base *url.URL, ref string) string {
+	u, err := url.Parse(ref)
+	if err != nil {
+		return ref
+	}
+
+	return base.ResolveReference(u).String()
+}
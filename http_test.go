@@ -0,0 +1,264 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newTestHTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/hello", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Add("X-Test", "a")
+		w.Header().Add("X-Test", "b")
+		fmt.Fprint(w, "Hello world")
+	})
+	mux.HandleFunc("/echo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		io.Copy(w, r.Body)
+	})
+	mux.HandleFunc("/json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"id": 1, "name": "test", "tags": ["a", "b"]}`)
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, strings.Repeat("a", maxHTTPBodySummaryLength+10))
+	})
+	mux.HandleFunc("/old", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/relative/old", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "new")
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("/no-location", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusFound)
+	})
+
+	return mux
+}
+
+func TestHTTPStatus(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	handler := newTestHTTPHandler()
+
+	testHTTPStatus(a, mockA, handler, http.MethodGet, "/hello", "", http.StatusOK, true)
+	testHTTPStatus(a, mockA, handler, http.MethodGet, "/hello", "", http.StatusCreated, false)
+	testHTTPStatus(a, mockA, handler, http.MethodGet, "/unknown", "", http.StatusNotFound, true)
+	testHTTPStatus(a, mockA, handler, http.MethodPost, "/echo", "test", http.StatusOK, true)
+	testHTTPStatus(a, mockA, handler, http.MethodGet, "/echo", "", http.StatusOK, false)
+
+	err := mockA.HTTPStatus(handler, http.MethodGet, "/hello", nil, http.StatusCreated)
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), "expect status code 201, got 200"))
+	a.TrueNow(strings.Contains(err.Error(), "HTTP/1.1 200 OK\n"))
+	a.TrueNow(strings.Contains(err.Error(), "Content-Type: text/plain\n"))
+	a.TrueNow(strings.Contains(err.Error(), "X-Test: a\nX-Test: b\n"))
+	a.TrueNow(strings.HasSuffix(err.Error(), "\n\nHello world"))
+}
+
+func testHTTPStatus(
+	a, mockA *Assertion,
+	handler http.Handler,
+	method, rawURL, body string,
+	code int,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPStatus", func() error {
+		return HTTPStatus(mockA.T, handler, method, rawURL, strings.NewReader(body), code)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.HTTPStatus", func() error {
+		return mockA.HTTPStatus(handler, method, rawURL, strings.NewReader(body), code)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPStatusNow", func() {
+		HTTPStatusNow(mockA.T, handler, method, rawURL, strings.NewReader(body), code)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.HTTPStatusNow", func() {
+		mockA.HTTPStatusNow(handler, method, rawURL, strings.NewReader(body), code)
+	}, !isOk)
+}
+
+func TestHTTPHeader(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	handler := newTestHTTPHandler()
+
+	testHTTPHeader(a, mockA, handler, "/hello", "Content-Type", "text/plain", true)
+	testHTTPHeader(a, mockA, handler, "/hello", "content-type", "text/plain", true)
+	testHTTPHeader(a, mockA, handler, "/hello", "Content-Type", "text/html", false)
+	testHTTPHeader(a, mockA, handler, "/hello", "X-Test", "a", true)
+	testHTTPHeader(a, mockA, handler, "/hello", "X-Test", "b", true)
+	testHTTPHeader(a, mockA, handler, "/hello", "X-Test", "c", false)
+	testHTTPHeader(a, mockA, handler, "/hello", "X-Unknown", "", false)
+
+	err := mockA.HTTPHeader(handler, http.MethodGet, "/hello", nil, "x-test", "c")
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), `expect header X-Test: c, got ["a" "b"]`))
+}
+
+func testHTTPHeader(
+	a, mockA *Assertion,
+	handler http.Handler,
+	rawURL, key, value string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPHeader", func() error {
+		return HTTPHeader(mockA.T, handler, http.MethodGet, rawURL, nil, key, value)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.HTTPHeader", func() error {
+		return mockA.HTTPHeader(handler, http.MethodGet, rawURL, nil, key, value)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPHeaderNow", func() {
+		HTTPHeaderNow(mockA.T, handler, http.MethodGet, rawURL, nil, key, value)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.HTTPHeaderNow", func() {
+		mockA.HTTPHeaderNow(handler, http.MethodGet, rawURL, nil, key, value)
+	}, !isOk)
+}
+
+func TestHTTPBodyContains(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	handler := newTestHTTPHandler()
+
+	testHTTPBodyContains(a, mockA, handler, http.MethodGet, "/hello", "", "Hello", true)
+	testHTTPBodyContains(a, mockA, handler, http.MethodGet, "/hello", "", "", true)
+	testHTTPBodyContains(a, mockA, handler, http.MethodGet, "/hello", "", "Goodbye", false)
+	testHTTPBodyContains(a, mockA, handler, http.MethodPost, "/echo", "ping", "ping", true)
+	testHTTPBodyContains(a, mockA, handler, http.MethodPost, "/echo", "ping", "pong", false)
+
+	err := mockA.HTTPBodyContains(handler, http.MethodGet, "/large", nil, "b")
+	a.NotNilNow(err)
+	a.TrueNow(strings.HasSuffix(err.Error(), "... (10 bytes truncated)"))
+}
+
+func testHTTPBodyContains(
+	a, mockA *Assertion,
+	handler http.Handler,
+	method, rawURL, body, substr string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPBodyContains", func() error {
+		return HTTPBodyContains(mockA.T, handler, method, rawURL, strings.NewReader(body), substr)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.HTTPBodyContains", func() error {
+		return mockA.HTTPBodyContains(handler, method, rawURL, strings.NewReader(body), substr)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPBodyContainsNow", func() {
+		HTTPBodyContainsNow(mockA.T, handler, method, rawURL, strings.NewReader(body), substr)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.HTTPBodyContainsNow", func() {
+		mockA.HTTPBodyContainsNow(handler, method, rawURL, strings.NewReader(body), substr)
+	}, !isOk)
+}
+
+func TestHTTPBodyJSONEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	handler := newTestHTTPHandler()
+
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json",
+		`{"tags":["a","b"],"name":"test","id":1}`, true)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json",
+		[]byte(`{"id":1,"name":"test","tags":["a","b"]}`), true)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json",
+		json.RawMessage(`{"id":1,"name":"test","tags":["a","b"]}`), true)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json", map[string]any{
+		"id":   1,
+		"name": "test",
+		"tags": []string{"a", "b"},
+	}, true)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json", struct {
+		ID   int      `json:"id"`
+		Name string   `json:"name"`
+		Tags []string `json:"tags"`
+	}{ID: 1, Name: "test", Tags: []string{"a", "b"}}, true)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json",
+		`{"id":1,"name":"test","tags":["b","a"]}`, false)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json", `{"id":1}`, false)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/json", `{invalid`, false)
+	testHTTPBodyJSONEqual(a, mockA, handler, "/hello", `"Hello world"`, false)
+
+	a.PanicNow(func() {
+		mockA.HTTPBodyJSONEqual(handler, http.MethodGet, "/json", nil, make(chan int))
+	})
+}
+
+func testHTTPBodyJSONEqual(
+	a, mockA *Assertion,
+	handler http.Handler,
+	rawURL string,
+	expected any,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPBodyJSONEqual", func() error {
+		return HTTPBodyJSONEqual(mockA.T, handler, http.MethodGet, rawURL, nil, expected)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.HTTPBodyJSONEqual", func() error {
+		return mockA.HTTPBodyJSONEqual(handler, http.MethodGet, rawURL, nil, expected)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPBodyJSONEqualNow", func() {
+		HTTPBodyJSONEqualNow(mockA.T, handler, http.MethodGet, rawURL, nil, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.HTTPBodyJSONEqualNow", func() {
+		mockA.HTTPBodyJSONEqualNow(handler, http.MethodGet, rawURL, nil, expected)
+	}, !isOk)
+}
+
+func TestHTTPRedirectsTo(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+	handler := newTestHTTPHandler()
+
+	testHTTPRedirectsTo(a, mockA, handler, "/old", "/new", true)
+	testHTTPRedirectsTo(a, mockA, handler, "/old", "http://example.com/new", true)
+	testHTTPRedirectsTo(a, mockA, handler, "/old", "/old", false)
+	testHTTPRedirectsTo(a, mockA, handler, "/relative/old", "/relative/new", true)
+	testHTTPRedirectsTo(a, mockA, handler, "/relative/old", "new", true)
+	testHTTPRedirectsTo(a, mockA, handler, "/relative/old", "/new", false)
+	testHTTPRedirectsTo(a, mockA, handler, "/no-location", "/new", false)
+	testHTTPRedirectsTo(a, mockA, handler, "/hello", "/hello", false)
+
+	err := mockA.HTTPRedirectsTo(handler, http.MethodGet, "/hello", nil, "/new")
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), `expect redirect to /new, got 200 ""`))
+}
+
+func testHTTPRedirectsTo(
+	a, mockA *Assertion,
+	handler http.Handler,
+	rawURL, location string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPRedirectsTo", func() error {
+		return HTTPRedirectsTo(mockA.T, handler, http.MethodGet, rawURL, nil, location)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.HTTPRedirectsTo", func() error {
+		return mockA.HTTPRedirectsTo(handler, http.MethodGet, rawURL, nil, location)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPRedirectsToNow", func() {
+		HTTPRedirectsToNow(mockA.T, handler, http.MethodGet, rawURL, nil, location)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.HTTPRedirectsToNow", func() {
+		mockA.HTTPRedirectsToNow(handler, http.MethodGet, rawURL, nil, location)
+	}, !isOk)
+}
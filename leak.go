@@ -0,0 +1,301 @@
+package assert
+
+import (
+	"fmt"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// defaultLeakGracePeriod is the default duration to wait for the goroutines to exit.
+const defaultLeakGracePeriod = time.Second
+
+var (
+	// goroutineHeaderPattern matches the header line of a goroutine in the stacks, for example,
+	// `goroutine 18 [running]:`.
+	goroutineHeaderPattern = regexp.MustCompile(`^goroutine (\d+) \[`)
+	// knownGoroutinePatterns are the patterns of the goroutines that are created by the Go runtime
+	// and the testing package, they will be always ignored by the leak detection.
+	knownGoroutinePatterns = []*regexp.Regexp{
+		// the goroutines that are running the tests, benchmarks, or fuzz tests
+		regexp.MustCompile(
+			`(?m)^testing\.(tRunner|runTests|RunTests|runFuzzing|runFuzzTests|\(\*M\)\.Run)\(`,
+		),
+		// the goroutines that are started by the runtime or the standard library, only the top
+		// frames of them are checked
+		regexp.MustCompile(
+			`\A[^\n]*\n(runtime\.ensureSigM|runtime\.ReadTrace|runtime/trace\.Start|os/signal\.)`,
+		),
+	}
+)
+
+// LeakOptions is the options of the goroutine leak detection.
+type LeakOptions struct {
+	// GracePeriod is the maximum duration to wait for the goroutines to exit before reporting them
+	// as leaked, and it'll be one second if it is not set.
+	GracePeriod time.Duration
+	// Ignores are the regular expression patterns of the goroutines to ignore, a goroutine will be
+	// ignored if its stack matches any of the patterns.
+	Ignores []string
+}
+
+// NoGoroutineLeak runs the function fn, and tests whether all the goroutines started during the
+// function call have exited or not. It'll wait for the goroutines to exit for a grace period of
+// one second, and set the result to fail with the stacks of the leaked goroutines if any goroutine
+// is still running after the grace period.
+//
+//	a := assert.New(t)
+//	a.NoGoroutineLeak(func() {
+//	  done := make(chan struct{})
+//	  go func() { <-done }()
+//	  close(done)
+//	}) // success
+//	a.NoGoroutineLeak(func() {
+//	  go func() { select {} }()
+//	}) // fail
+func (a *Assertion) NoGoroutineLeak(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryNoGoroutineLeak(a.testingT(), false, fn, LeakOptions{}, message...)
+}
+
+// NoGoroutineLeakNow runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not. It'll terminate the execution if any goroutine is still
+// running after the grace period.
+//
+//	a := assert.New(t)
+//	a.NoGoroutineLeakNow(func() {
+//	  go func() { select {} }()
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) NoGoroutineLeakNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryNoGoroutineLeak(a.testingT(), true, fn, LeakOptions{}, message...)
+}
+
+// NoGoroutineLeakWith runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not with the options. The goroutines whose stacks match any of
+// the ignore patterns will not be treated as leaked.
+//
+//	a := assert.New(t)
+//	a.NoGoroutineLeakWith(func() {
+//	  go backgroundWorker()
+//	}, assert.LeakOptions{Ignores: []string{`backgroundWorker`}}) // success
+//	a.NoGoroutineLeakWith(func() {
+//	  go func() { time.Sleep(time.Second) }()
+//	}, assert.LeakOptions{GracePeriod: 100 * time.Millisecond}) // fail
+func (a *Assertion) NoGoroutineLeakWith(fn func(), options LeakOptions, message ...any) error {
+	a.Helper()
+
+	return tryNoGoroutineLeak(a.testingT(), false, fn, options, message...)
+}
+
+// NoGoroutineLeakWithNow runs the function fn, and tests whether all the goroutines started during
+// the function call have exited or not with the options. It'll terminate the execution if any
+// goroutine is still running after the grace period.
+//
+//	a := assert.New(t)
+//	a.NoGoroutineLeakWithNow(func() {
+//	  go func() { time.Sleep(time.Second) }()
+//	}, assert.LeakOptions{GracePeriod: 100 * time.Millisecond}) // fail and terminate
+//	// never runs
+func (a *Assertion) NoGoroutineLeakWithNow(fn func(), options LeakOptions, message ...any) error {
+	a.Helper()
+
+	return tryNoGoroutineLeak(a.testingT(), true, fn, options, message...)
+}
+
+// VerifyNoLeaks takes a snapshot of the running goroutines, and registers a cleanup function to
+// the test to check whether all the goroutines started after calling it have exited when the test
+// and all its subtests completed. It'll set the result to fail with the stacks of the leaked
+// goroutines if any goroutine is still running after the grace period.
+//
+//	func TestSomething(t *testing.T) {
+//	  a := assert.New(t)
+//	  a.VerifyNoLeaks()
+//	  // ...
+//	}
+func (a *Assertion) VerifyNoLeaks() {
+	a.Helper()
+
+	verifyNoLeaks(a.T, LeakOptions{})
+}
+
+// VerifyNoLeaksWith takes a snapshot of the running goroutines, and registers a cleanup function
+// to the test to check whether all the goroutines started after calling it have exited with the
+// options when the test and all its subtests completed.
+//
+//	func TestSomething(t *testing.T) {
+//	  a := assert.New(t)
+//	  a.VerifyNoLeaksWith(assert.LeakOptions{Ignores: []string{`backgroundWorker`}})
+//	  // ...
+//	}
+func (a *Assertion) VerifyNoLeaksWith(options LeakOptions) {
+	a.Helper()
+
+	verifyNoLeaks(a.T, options)
+}
+
+// tryNoGoroutineLeak tries to run the function, and it'll fail if any goroutine started during the
+// function call is still running after the grace period.
+func tryNoGoroutineLeak(
+	t testingT,
+	failedNow bool,
+	fn func(),
+	options LeakOptions,
+	message ...any,
+) error {
+	t.Helper()
+
+	before := getGoroutineStacks()
+
+	fn()
+
+	return tryGoroutineLeak(t, failedNow, before, options, message...)
+}
+
+// verifyNoLeaks takes a snapshot of the running goroutines, and registers a cleanup function to
+// check the leaked goroutines after the test completed.
+func verifyNoLeaks(t *testing.T, options LeakOptions) {
+	t.Helper()
+
+	before := getGoroutineStacks()
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		tryGoroutineLeak(t, false, before, options)
+	})
+}
+
+// tryGoroutineLeak tries to find the goroutines that are not in the snapshot, and it'll fail if
+// any of them is still running after the grace period.
+func tryGoroutineLeak(
+	t testingT,
+	failedNow bool,
+	before map[int]string,
+	options LeakOptions,
+	message ...any,
+) error {
+	t.Helper()
+
+	leaked := findLeakedGoroutines(before, options)
+
+	return test(
+		t,
+		func() bool { return len(leaked) == 0 },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageGoroutineLeak, len(leaked), strings.Join(leaked, "\n\n")),
+		message...,
+	)
+}
+
+// findLeakedGoroutines waits for the goroutines that are not in the snapshot to exit until the
+// grace period elapsed, and returns the stacks of the goroutines that are still running. It'll
+// panic if any of the ignore patterns is not a valid regular expression.
+func findLeakedGoroutines(before map[int]string, options LeakOptions) []string {
+	ignores := make([]*regexp.Regexp, 0, len(options.Ignores))
+	for _, pattern := range options.Ignores {
+		ignores = append(ignores, regexp.MustCompile(pattern))
+	}
+
+	gracePeriod := options.GracePeriod
+	if gracePeriod <= 0 {
+		gracePeriod = defaultLeakGracePeriod
+	}
+	deadline := time.Now().Add(gracePeriod)
+	interval := time.Millisecond
+
+	for {
+		leaked := getNewGoroutines(before, ignores)
+		if len(leaked) == 0 || !time.Now().Before(deadline) {
+			return leaked
+		}
+
+		time.Sleep(interval)
+		if interval < 100*time.Millisecond {
+			interval *= 2
+		}
+	}
+}
+
+// getNewGoroutines returns the stacks of the running goroutines that are not in the snapshot and
+// not ignored, and they are sorted by the goroutine ids.
+func getNewGoroutines(before map[int]string, ignores []*regexp.Regexp) []string {
+	current := getGoroutineStacks()
+
+	ids := make([]int, 0)
+	for id, stack := range current {
+		if _, ok := before[id]; ok || isIgnoredGoroutine(stack, ignores) {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	stacks := make([]string, 0, len(ids))
+	for _, id := range ids {
+		stacks = append(stacks, current[id])
+	}
+
+	return stacks
+}
+
+// isIgnoredGoroutine checks whether the goroutine is created by the runtime or the testing
+// package, or its stack matches any of the ignore patterns.
+func isIgnoredGoroutine(stack string, ignores []*regexp.Regexp) bool {
+	for _, re := range knownGoroutinePatterns {
+		if re.MatchString(stack) {
+			return true
+		}
+	}
+	for _, re := range ignores {
+		if re.MatchString(stack) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getGoroutineStacks returns the stacks of all the running goroutines except the current one, and
+// the keys of the map are the goroutine ids.
+func getGoroutineStacks() map[int]string {
+	stacks := make(map[int]string)
+	// the first goroutine in the stacks is always the current goroutine
+	for i, stack := range strings.Split(dumpGoroutineStacks(), "\n\n") {
+		if i == 0 {
+			continue
+		}
+
+		matches := goroutineHeaderPattern.FindStringSubmatch(stack)
+		if matches == nil {
+			continue
+		}
+		id, err := strconv.Atoi(matches[1])
+		if err != nil {
+			continue
+		}
+		stacks[id] = strings.TrimSpace(stack)
+	}
+
+	return stacks
+}
+
+// dumpGoroutineStacks returns the stacks of all the running goroutines, and the first one is the
+// stack of the current goroutine.
+func dumpGoroutineStacks() string {
+	buf := make([]byte, 64*1024)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			return string(buf[:n])
+		}
+		buf = make([]byte, len(buf)*2)
+	}
+}
@@ -0,0 +1,166 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func testLeakedWorker(stop chan struct{}) {
+	<-stop
+}
+
+func TestNoGoroutineLeak(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	options := LeakOptions{GracePeriod: 50 * time.Millisecond}
+
+	testNoGoroutineLeak(a, mockA, func() {}, options, true)
+	testNoGoroutineLeak(a, mockA, func() {
+		done := make(chan struct{})
+		go func() { <-done }()
+		close(done)
+	}, options, true)
+	testNoGoroutineLeak(a, mockA, func() {
+		go func() { time.Sleep(10 * time.Millisecond) }()
+	}, options, true)
+	testNoGoroutineLeak(a, mockA, func() {
+		go testLeakedWorker(stop)
+	}, options, false)
+	testNoGoroutineLeak(a, mockA, func() {
+		go testLeakedWorker(stop)
+	}, LeakOptions{
+		GracePeriod: 50 * time.Millisecond,
+		Ignores:     []string{`testLeakedWorker`},
+	}, true)
+
+	a.PanicNow(func() {
+		mockA.NoGoroutineLeakWith(func() {}, LeakOptions{Ignores: []string{`(`}})
+	})
+}
+
+func testNoGoroutineLeak(a, mockA *Assertion, fn func(), options LeakOptions, isOk bool) {
+	a.Helper()
+
+	if isOk && len(options.Ignores) == 0 {
+		// the default options are only used for the success cases to avoid long waiting
+		testAssertionFunction(a, "NoGoroutineLeak", func() error {
+			return NoGoroutineLeak(mockA.T, fn)
+		}, isOk)
+		testAssertionFunction(a, "Assertion.NoGoroutineLeak", func() error {
+			return mockA.NoGoroutineLeak(fn)
+		}, isOk)
+		testAssertionNowFunction(a, "NoGoroutineLeakNow", func() {
+			NoGoroutineLeakNow(mockA.T, fn)
+		}, !isOk)
+		testAssertionNowFunction(a, "Assertion.NoGoroutineLeakNow", func() {
+			mockA.NoGoroutineLeakNow(fn)
+		}, !isOk)
+	}
+
+	testAssertionFunction(a, "NoGoroutineLeakWith", func() error {
+		return NoGoroutineLeakWith(mockA.T, fn, options)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.NoGoroutineLeakWith", func() error {
+		return mockA.NoGoroutineLeakWith(fn, options)
+	}, isOk)
+	testAssertionNowFunction(a, "NoGoroutineLeakWithNow", func() {
+		NoGoroutineLeakWithNow(mockA.T, fn, options)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.NoGoroutineLeakWithNow", func() {
+		mockA.NoGoroutineLeakWithNow(fn, options)
+	}, !isOk)
+}
+
+func TestNoGoroutineLeakMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	err := mockA.NoGoroutineLeakWith(func() {
+		go testLeakedWorker(stop)
+	}, LeakOptions{GracePeriod: 10 * time.Millisecond})
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), "found 1 leaked goroutine(s)"), err.Error())
+	a.TrueNow(strings.Contains(err.Error(), "testLeakedWorker"), err.Error())
+}
+
+func TestVerifyNoLeaks(t *testing.T) {
+	a := New(t)
+
+	a.Run("Assertion.VerifyNoLeaks", func(a *Assertion) {
+		a.VerifyNoLeaks()
+
+		go func() { time.Sleep(10 * time.Millisecond) }()
+	})
+	a.Run("Assertion.VerifyNoLeaksWith", func(a *Assertion) {
+		a.VerifyNoLeaksWith(LeakOptions{GracePeriod: 50 * time.Millisecond})
+
+		go func() { time.Sleep(10 * time.Millisecond) }()
+	})
+	t.Run("VerifyNoLeaks", func(t *testing.T) {
+		VerifyNoLeaks(t)
+
+		go func() { time.Sleep(10 * time.Millisecond) }()
+	})
+	t.Run("VerifyNoLeaksWith", func(t *testing.T) {
+		VerifyNoLeaksWith(t, LeakOptions{GracePeriod: 50 * time.Millisecond})
+
+		go func() { time.Sleep(10 * time.Millisecond) }()
+	})
+}
+
+func TestTryGoroutineLeak(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	options := LeakOptions{GracePeriod: 10 * time.Millisecond}
+
+	before := getGoroutineStacks()
+	a.NilNow(tryGoroutineLeak(mockT, false, before, options))
+
+	go testLeakedWorker(stop)
+	a.NotNilNow(tryGoroutineLeak(mockT, false, before, options))
+	a.TrueNow(mockT.Failed())
+}
+
+func TestGetGoroutineStacks(t *testing.T) {
+	a := New(t)
+
+	stop := make(chan struct{})
+	started := make(chan struct{})
+
+	before := getGoroutineStacks()
+	go func() {
+		close(started)
+		testLeakedWorker(stop)
+	}()
+	<-started
+
+	after := getGoroutineStacks()
+	close(stop)
+
+	found := 0
+	for id, stack := range after {
+		if _, ok := before[id]; ok {
+			continue
+		}
+		a.TrueNow(strings.HasPrefix(stack, "goroutine "), stack)
+		a.TrueNow(strings.Contains(stack, "testLeakedWorker"), stack)
+		found++
+	}
+	a.EqualNow(found, 1)
+
+	for _, stack := range after {
+		a.NotTrueNow(strings.Contains(stack, "TestGetGoroutineStacks("), stack)
+	}
+}
@@ -3,7 +3,6 @@ package assert
 import (
 	"fmt"
 	"reflect"
-	"testing"
 )
 
 // MapHasKey tests whether the map contains the specified key or not, it will fail if the map does
@@ -16,7 +15,7 @@ import (
 func (a *Assertion) MapHasKey(m, key any, message ...any) error {
 	a.Helper()
 
-	return tryMapHasKey(a.T, false, m, key, message...)
+	return tryMapHasKey(a.testingT(), false, m, key, message...)
 }
 
 // MapHasKeyNow tests whether the map contains the specified key or not, and it will terminate the
@@ -30,7 +29,7 @@ func (a *Assertion) MapHasKey(m, key any, message ...any) error {
 func (a *Assertion) MapHasKeyNow(m, key any, message ...any) error {
 	a.Helper()
 
-	return tryMapHasKey(a.T, true, m, key, message...)
+	return tryMapHasKey(a.testingT(), true, m, key, message...)
 }
 
 // NotMapHasKey tests whether the map contains the specified key or not, it will fail if the map
@@ -44,7 +43,7 @@ func (a *Assertion) MapHasKeyNow(m, key any, message ...any) error {
 func (a *Assertion) NotMapHasKey(m, key any, message ...any) error {
 	a.Helper()
 
-	return tryNotMapHasKey(a.T, false, m, key, message...)
+	return tryNotMapHasKey(a.testingT(), false, m, key, message...)
 }
 
 // NotMapHasKeyNow tests whether the map contains the specified key or not, it will fail if the map
@@ -59,13 +58,13 @@ func (a *Assertion) NotMapHasKey(m, key any, message ...any) error {
 func (a *Assertion) NotMapHasKeyNow(m, key any, message ...any) error {
 	a.Helper()
 
-	return tryNotMapHasKey(a.T, true, m, key, message...)
+	return tryNotMapHasKey(a.testingT(), true, m, key, message...)
 }
 
 // tryMapHasKey tries to test whether the map contains the specified key or not, and it'll fail if
 // the map does not contains the specified key.
 func tryMapHasKey(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	m, key any,
 	message ...any,
@@ -84,7 +83,7 @@ func tryMapHasKey(
 // tryNotMapHasKey tries to test whether the map contains the specified key or not, and it'll fail
 // if the map contains the specified key.
 func tryNotMapHasKey(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	m, key any,
 	message ...any,
@@ -111,7 +110,7 @@ func tryNotMapHasKey(
 func (a *Assertion) MapHasValue(m, value any, message ...any) error {
 	a.Helper()
 
-	return tryMapHasValue(a.T, false, m, value, message...)
+	return tryMapHasValue(a.testingT(), false, m, value, message...)
 }
 
 // MapHasValueNow tests whether the map contains the specified value or not, and it will terminate
@@ -125,7 +124,7 @@ func (a *Assertion) MapHasValue(m, value any, message ...any) error {
 func (a *Assertion) MapHasValueNow(m, value any, message ...any) error {
 	a.Helper()
 
-	return tryMapHasValue(a.T, true, m, value, message...)
+	return tryMapHasValue(a.testingT(), true, m, value, message...)
 }
 
 // NotMapHasValue tests whether the map contains the specified value or not, it will fail if the
@@ -139,7 +138,7 @@ func (a *Assertion) MapHasValueNow(m, value any, message ...any) error {
 func (a *Assertion) NotMapHasValue(m, value any, message ...any) error {
 	a.Helper()
 
-	return tryNotMapHasValue(a.T, false, m, value, message...)
+	return tryNotMapHasValue(a.testingT(), false, m, value, message...)
 }
 
 // NotMapHasValueNow tests whether the map contains the specified value or not, it will fail if the
@@ -155,13 +154,13 @@ func (a *Assertion) NotMapHasValue(m, value any, message ...any) error {
 func (a *Assertion) NotMapHasValueNow(m, value any, message ...any) error {
 	a.Helper()
 
-	return tryNotMapHasValue(a.T, true, m, value, message...)
+	return tryNotMapHasValue(a.testingT(), true, m, value, message...)
 }
 
 // tryMapHasValue tries to test whether the map contains the specified value or not, and it'll fail
 // if the map does not contains the specified value.
 func tryMapHasValue(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	m, value any,
 	message ...any,
@@ -180,7 +179,7 @@ func tryMapHasValue(
 // tryNotMapHasValue tries to test whether the map contains the specified value or not, and it'll
 // fail if the map contains the specified value.
 func tryNotMapHasValue(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	m, value any,
 	message ...any,
@@ -2,8 +2,9 @@ package assert
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
-	"testing"
+	"time"
 )
 
 const (
@@ -12,6 +13,11 @@ const (
 	compareTypeLess         = 1 << 2
 )
 
+var (
+	intType  = reflect.TypeOf(0)
+	boolType = reflect.TypeOf(false)
+)
+
 const (
 	compareValueTypeInt int = iota
 	compareValueTypeUint
@@ -32,11 +38,11 @@ func (a *Assertion) Gt(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		false,
 		compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGt, v1, v2),
+		fmt.Sprintf(defaultErrMessageGt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -54,11 +60,11 @@ func (a *Assertion) GtNow(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		true,
 		compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGt, v1, v2),
+		fmt.Sprintf(defaultErrMessageGt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -76,11 +82,11 @@ func (a *Assertion) Gte(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		false,
 		compareTypeEqual|compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGte, v1, v2),
+		fmt.Sprintf(defaultErrMessageGte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -99,11 +105,11 @@ func (a *Assertion) GteNow(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		true,
 		compareTypeEqual|compareTypeGreater,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageGte, v1, v2),
+		fmt.Sprintf(defaultErrMessageGte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -121,11 +127,11 @@ func (a *Assertion) Lt(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		false,
 		compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLt, v1, v2),
+		fmt.Sprintf(defaultErrMessageLt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -143,11 +149,11 @@ func (a *Assertion) LtNow(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		true,
 		compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLt, v1, v2),
+		fmt.Sprintf(defaultErrMessageLt, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -165,11 +171,11 @@ func (a *Assertion) Lte(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		false,
 		compareTypeEqual|compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLte, v1, v2),
+		fmt.Sprintf(defaultErrMessageLte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -188,11 +194,11 @@ func (a *Assertion) LteNow(v1, v2 any, message ...string) error {
 	a.T.Helper()
 
 	return tryCompareOrderableValues(
-		a.T,
+		a.testingT(),
 		true,
 		compareTypeEqual|compareTypeLess,
 		v1, v2,
-		fmt.Sprintf(defaultErrMessageLte, v1, v2),
+		fmt.Sprintf(defaultErrMessageLte, formatValue(v1), formatValue(v2)),
 		message...,
 	)
 }
@@ -200,7 +206,7 @@ func (a *Assertion) LteNow(v1, v2 any, message ...string) error {
 // tryCompareOrderableValues tries to compare the values by the comparison type, and returns an
 // error if the result does not match.
 func tryCompareOrderableValues(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	compareType uint,
 	v1, v2 any,
@@ -229,6 +235,12 @@ func tryCompareOrderableValues(
 
 // compareValues tries to compare the values by the comparison type.
 func compareValues(v1, v2 reflect.Value, compareType uint) bool {
+	if ret, ok := compareObjects(v1, v2); ok {
+		return ((compareType&compareTypeEqual) > 0 && ret == 0) ||
+			((compareType&compareTypeGreater) > 0 && ret > 0) ||
+			((compareType&compareTypeLess) > 0 && ret < 0)
+	}
+
 	k := v1.Kind()
 	t := 0 // 1: int, 2: uint, 3: float, 4: string
 	var i1, i2 int64
@@ -285,6 +297,85 @@ func compareValues(v1, v2 reflect.Value, compareType uint) bool {
 	return false
 }
 
+// compareObjects tries to compare the values that are not the builtin orderable types, and
+// returns -1, 0, or 1 like the `Compare` methods. It supports `time.Time`, `*big.Int`,
+// `*big.Float`, `*big.Rat`, and the types that implement a `Compare(T) int` method or a
+// `Less(T) bool` method. The second return value will be false if the values can't be compared by
+// this function, and it'll panic with ErrNotOrderable if any of the big numbers is nil.
+func compareObjects(v1, v2 reflect.Value) (int, bool) {
+	if !v1.IsValid() || !v2.IsValid() || v1.Type() != v2.Type() ||
+		!v1.CanInterface() || !v2.CanInterface() {
+		return 0, false
+	}
+
+	switch x := v1.Interface().(type) {
+	case time.Time:
+		y := v2.Interface().(time.Time)
+		if x.Before(y) {
+			return -1, true
+		} else if x.After(y) {
+			return 1, true
+		}
+		return 0, true
+	case *big.Int:
+		y := v2.Interface().(*big.Int)
+		if x == nil || y == nil {
+			panic(ErrNotOrderable)
+		}
+		return x.Cmp(y), true
+	case *big.Float:
+		y := v2.Interface().(*big.Float)
+		if x == nil || y == nil {
+			panic(ErrNotOrderable)
+		}
+		return x.Cmp(y), true
+	case *big.Rat:
+		y := v2.Interface().(*big.Rat)
+		if x == nil || y == nil {
+			panic(ErrNotOrderable)
+		}
+		return x.Cmp(y), true
+	}
+
+	if method := getCompareMethod(v1.Type(), "Compare", intType); method.Func.IsValid() {
+		ret := method.Func.Call([]reflect.Value{v1, v2})[0].Int()
+		if ret < 0 {
+			return -1, true
+		} else if ret > 0 {
+			return 1, true
+		}
+		return 0, true
+	}
+
+	if method := getCompareMethod(v1.Type(), "Less", boolType); method.Func.IsValid() {
+		if method.Func.Call([]reflect.Value{v1, v2})[0].Bool() {
+			return -1, true
+		} else if method.Func.Call([]reflect.Value{v2, v1})[0].Bool() {
+			return 1, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// getCompareMethod gets the method by the name that accepts a value of the same type and returns a
+// value of the out type, for example, `Compare(T) int` or `Less(T) bool`. It returns a zero
+// `reflect.Method` if the type has no such method.
+func getCompareMethod(typ reflect.Type, name string, out reflect.Type) reflect.Method {
+	method, ok := typ.MethodByName(name)
+	if !ok {
+		return reflect.Method{}
+	}
+
+	mt := method.Type // the first parameter is the receiver
+	if mt.NumIn() != 2 || mt.NumOut() != 1 || mt.In(1) != typ || mt.Out(0) != out {
+		return reflect.Method{}
+	}
+
+	return method
+}
+
 // isOrderable gets the type of the value, and checks whether the type is comparable or not.
 func isOrderable(v any) bool {
 	switch v.(type) {
@@ -292,10 +383,17 @@ func isOrderable(v any) bool {
 		int, int8, int16, int32, int64, // Signed integer
 		uint, uint8, uint16, uint32, uint64, uintptr, // Unsigned integer
 		float32, float64, // Floating-point number
-		string: // string
+		string,                                    // string
+		time.Time, *big.Int, *big.Float, *big.Rat: // Time and big numbers
 		return true
 	default:
-		kind := reflect.TypeOf(v).Kind()
+		typ := reflect.TypeOf(v)
+		if getCompareMethod(typ, "Compare", intType).Func.IsValid() ||
+			getCompareMethod(typ, "Less", boolType).Func.IsValid() {
+			return true
+		}
+
+		kind := typ.Kind()
 		return (kind >= reflect.Int && kind <= reflect.Int64) ||
 			(kind >= reflect.Uint && kind <= reflect.Uintptr) ||
 			(kind >= reflect.Float32 && kind <= reflect.Float64) ||
@@ -1,11 +1,29 @@
 package assert
 
 import (
+	"math/big"
 	"reflect"
 	"testing"
 	"time"
 )
 
+type testComparableVersion struct {
+	major, minor int
+}
+
+func (v testComparableVersion) Compare(o testComparableVersion) int {
+	if v.major != o.major {
+		return v.major - o.major
+	}
+	return v.minor - o.minor
+}
+
+type testLessPriority int
+
+func (p testLessPriority) Less(o testLessPriority) bool {
+	return p > o // higher priority first
+}
+
 func TestGt(t *testing.T) {
 	a := New(t)
 	mockA := New(new(testing.T))
@@ -200,6 +218,65 @@ func TestIsOrderable(t *testing.T) {
 	assert.True(isOrderable(float32(1.0)))
 	assert.True(isOrderable(1.0))
 	assert.True(isOrderable("Hello"))
+	assert.True(isOrderable(time.Now()))
+	assert.True(isOrderable(big.NewInt(1)))
+	assert.True(isOrderable(big.NewFloat(1.0)))
+	assert.True(isOrderable(big.NewRat(1, 2)))
+	assert.True(isOrderable(testComparableVersion{}))
+	assert.True(isOrderable(testLessPriority(1)))
 	assert.NotTrue(isOrderable([]byte{'H', 'e', 'l', 'l', 'o'}))
 	assert.NotTrue(isOrderable([]int{1, 2, 3}))
 }
+
+func TestCompareObjects(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	now := time.Now()
+	later := now.Add(time.Second)
+
+	testGt(a, mockA, later, now, true)
+	testGt(a, mockA, now, now.In(time.UTC), false)
+	testGte(a, mockA, now, now.In(time.UTC), true)
+	testLt(a, mockA, now, later, true)
+	testLte(a, mockA, later, now, false)
+
+	testGt(a, mockA, big.NewInt(2), big.NewInt(1), true)
+	testGt(a, mockA, big.NewInt(1), big.NewInt(1), false)
+	testLte(a, mockA, big.NewInt(1), big.NewInt(1), true)
+	testGt(a, mockA, big.NewFloat(1.5), big.NewFloat(1.25), true)
+	testLt(a, mockA, big.NewFloat(1.5), big.NewFloat(1.25), false)
+	testGt(a, mockA, big.NewRat(1, 2), big.NewRat(1, 3), true)
+	testGte(a, mockA, big.NewRat(1, 2), big.NewRat(2, 4), true)
+	testLt(a, mockA, big.NewRat(1, 2), big.NewRat(1, 3), false)
+
+	a.PanicOfNow(func() {
+		mockA.Gt((*big.Int)(nil), big.NewInt(1))
+	}, ErrNotOrderable)
+	a.PanicOfNow(func() {
+		mockA.Lt(big.NewFloat(1), (*big.Float)(nil))
+	}, ErrNotOrderable)
+	a.PanicOfNow(func() {
+		mockA.Gte((*big.Rat)(nil), (*big.Rat)(nil))
+	}, ErrNotOrderable)
+
+	testGt(a, mockA, testComparableVersion{1, 2}, testComparableVersion{1, 1}, true)
+	testGt(a, mockA, testComparableVersion{1, 2}, testComparableVersion{2, 0}, false)
+	testGte(a, mockA, testComparableVersion{1, 2}, testComparableVersion{1, 2}, true)
+	testLt(a, mockA, testComparableVersion{1, 2}, testComparableVersion{2, 0}, true)
+
+	testLt(a, mockA, testLessPriority(2), testLessPriority(1), true)
+	testLt(a, mockA, testLessPriority(1), testLessPriority(2), false)
+	testGt(a, mockA, testLessPriority(1), testLessPriority(2), true)
+	testLte(a, mockA, testLessPriority(1), testLessPriority(1), true)
+	testGte(a, mockA, testLessPriority(1), testLessPriority(1), true)
+
+	err := New(new(testing.T)).Gt(
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+	)
+	a.EqualNow(
+		err.Error(),
+		"assert error: 2024-01-01T00:00:00Z must greater than 2024-01-02T00:00:00Z",
+	)
+}
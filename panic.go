@@ -1,8 +1,42 @@
 package assert
 
 import (
+	"errors"
 	"fmt"
-	"testing"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// The descriptions of the kinds of the runtime errors for the failure messages.
+const (
+	runtimeErrorNilDereference  = "nil pointer dereference"
+	runtimeErrorIndexOutOfRange = "index out of range"
+	runtimeErrorDivideByZero    = "divide by zero"
+	runtimeErrorTypeAssertion   = "type assertion"
+)
+
+// The runtime errors and the types of them that are triggered and recovered at the initialization,
+// to classify the runtime errors without depending on their messages.
+var (
+	// nilDereferenceError is the runtime error of a nil pointer dereference.
+	nilDereferenceError = recoverRuntimeError(func() {
+		var p *int
+		_ = *p
+	})
+	// divideByZeroError is the runtime error of an integer divide by zero.
+	divideByZeroError = recoverRuntimeError(func() {
+		zero := 0
+		_ = 1 / zero
+	})
+	// indexOutOfRangeErrorType is the type of the runtime errors of the index and slice bounds out
+	// of range.
+	indexOutOfRangeErrorType = reflect.TypeOf(recoverRuntimeError(func() {
+		s := []int{}
+		i := 1
+		_ = s[i]
+	}))
 )
 
 // Panic expects the function fn to panic, and it'll set the result to fail if the function doesn't
@@ -19,7 +53,7 @@ import (
 func (a *Assertion) Panic(fn func(), message ...any) error {
 	a.Helper()
 
-	return tryPanic(a.T, false, fn, message...)
+	return tryPanic(a.testingT(), false, fn, message...)
 }
 
 // PanicNow expects the function fn to panic. It'll set the result to fail if the function doesn't
@@ -37,7 +71,7 @@ func (a *Assertion) Panic(fn func(), message ...any) error {
 func (a *Assertion) PanicNow(fn func(), message ...any) error {
 	a.Helper()
 
-	return tryPanic(a.T, true, fn, message...)
+	return tryPanic(a.testingT(), true, fn, message...)
 }
 
 // NotPanic asserts that the function fn does not panic, and it'll set the result to fail if the
@@ -54,7 +88,7 @@ func (a *Assertion) PanicNow(fn func(), message ...any) error {
 func (a *Assertion) NotPanic(fn func(), message ...any) error {
 	a.Helper()
 
-	return tryNotPanic(a.T, false, fn, message...)
+	return tryNotPanic(a.testingT(), false, fn, message...)
 }
 
 // NotPanicNow asserts that the function fn does not panic. It'll set the result to fail if the
@@ -72,12 +106,12 @@ func (a *Assertion) NotPanic(fn func(), message ...any) error {
 func (a *Assertion) NotPanicNow(fn func(), message ...any) error {
 	a.Helper()
 
-	return tryNotPanic(a.T, true, fn, message...)
+	return tryNotPanic(a.testingT(), true, fn, message...)
 }
 
 // tryPanic executes the function fn, and try to catching the panic error. It expect the function
 // fn to panic, and returns error if fn does not panic.
-func tryPanic(t *testing.T, failedNow bool, fn func(), message ...any) error {
+func tryPanic(t testingT, failedNow bool, fn func(), message ...any) error {
 	t.Helper()
 
 	e := isPanic(fn)
@@ -93,7 +127,7 @@ func tryPanic(t *testing.T, failedNow bool, fn func(), message ...any) error {
 
 // tryNotPanic executes the function fn, and try to catching the panic error. It expect the
 // function fn does not to panic, and returns error if panic.
-func tryNotPanic(t *testing.T, failedNow bool, fn func(), message ...any) error {
+func tryNotPanic(t testingT, failedNow bool, fn func(), message ...any) error {
 	t.Helper()
 
 	e := isPanic(fn)
@@ -122,7 +156,7 @@ func tryNotPanic(t *testing.T, failedNow bool, fn func(), message ...any) error
 func (a *Assertion) PanicOf(fn func(), expectErr any, message ...any) error {
 	a.Helper()
 
-	return tryPanicOf(a.T, false, fn, expectErr, message...)
+	return tryPanicOf(a.testingT(), false, fn, expectErr, message...)
 }
 
 // PanicOfNow expects the function fn to panic by the expected error. If the function does not
@@ -139,7 +173,7 @@ func (a *Assertion) PanicOf(fn func(), expectErr any, message ...any) error {
 func (a *Assertion) PanicOfNow(fn func(), expectErr any, message ...any) error {
 	a.Helper()
 
-	return tryPanicOf(a.T, true, fn, expectErr, message...)
+	return tryPanicOf(a.testingT(), true, fn, expectErr, message...)
 }
 
 // NotPanicOf expects the function fn not panic, or the function does not panic by the unexpected
@@ -158,7 +192,7 @@ func (a *Assertion) PanicOfNow(fn func(), expectErr any, message ...any) error {
 func (a *Assertion) NotPanicOf(fn func(), unexpectedErr any, message ...any) error {
 	a.Helper()
 
-	return tryNotPanicOf(a.T, false, fn, unexpectedErr, message...)
+	return tryNotPanicOf(a.testingT(), false, fn, unexpectedErr, message...)
 }
 
 // NotPanicOfNow expects the function fn not panic, or the function does not panic by the
@@ -179,11 +213,11 @@ func (a *Assertion) NotPanicOf(fn func(), unexpectedErr any, message ...any) err
 func (a *Assertion) NotPanicOfNow(fn func(), unexpectedErr any, message ...any) error {
 	a.Helper()
 
-	return tryNotPanicOf(a.T, true, fn, unexpectedErr, message...)
+	return tryNotPanicOf(a.testingT(), true, fn, unexpectedErr, message...)
 }
 
 // tryPanicOf executes the function fn, and it expects the function to panic by the expected error.
-func tryPanicOf(t *testing.T, failedNow bool, fn func(), expectError any, message ...any) error {
+func tryPanicOf(t testingT, failedNow bool, fn func(), expectError any, message ...any) error {
 	t.Helper()
 
 	e := isPanic(fn)
@@ -198,7 +232,7 @@ func tryPanicOf(t *testing.T, failedNow bool, fn func(), expectError any, messag
 }
 
 func tryNotPanicOf(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	fn func(),
 	unexpectedError any,
@@ -217,6 +251,591 @@ func tryNotPanicOf(
 	return err
 }
 
+// PanicIs expects the function fn to panic by an error that matches the target error by
+// `errors.Is`. If the function does not panic, or the recovered value is not an error or does not
+// match the target, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicIs(func() {
+//	  panic(fmt.Errorf("read config: %w", ErrNotFound))
+//	}, ErrNotFound) // success
+//	a.PanicIs(func() {
+//	  panic("not found")
+//	}, ErrNotFound) // fail
+func (a *Assertion) PanicIs(fn func(), target error, message ...any) error {
+	a.Helper()
+
+	return tryPanicIs(a.testingT(), false, fn, target, message...)
+}
+
+// PanicIsNow expects the function fn to panic by an error that matches the target error by
+// `errors.Is`. If the function does not panic, or the recovered value is not an error or does not
+// match the target, it will set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicIsNow(func() {
+//	  panic(fmt.Errorf("read config: %w", ErrNotFound))
+//	}, ErrNotFound) // success
+//	a.PanicIsNow(func() {
+//	  panic("not found")
+//	}, ErrNotFound) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicIsNow(fn func(), target error, message ...any) error {
+	a.Helper()
+
+	return tryPanicIs(a.testingT(), true, fn, target, message...)
+}
+
+// PanicAs expects the function fn to panic by an error that matches the target by `errors.As`,
+// and sets the target to the matched error. If the function does not panic, or the recovered value
+// is not an error or does not match the target, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	var pathErr *fs.PathError
+//	a.PanicAs(func() {
+//	  _, err := os.Open("not-exist-file")
+//	  panic(err)
+//	}, &pathErr) // success
+//	a.PanicAs(func() {
+//	  panic("some error")
+//	}, &pathErr) // fail
+func (a *Assertion) PanicAs(fn func(), target any, message ...any) error {
+	a.Helper()
+
+	return tryPanicAs(a.testingT(), false, fn, target, message...)
+}
+
+// PanicAsNow expects the function fn to panic by an error that matches the target by `errors.As`,
+// and sets the target to the matched error. If the function does not panic, or the recovered value
+// is not an error or does not match the target, it will set the result to fail and terminate the
+// execution.
+//
+//	a := assert.New(t)
+//	var pathErr *fs.PathError
+//	a.PanicAsNow(func() {
+//	  _, err := os.Open("not-exist-file")
+//	  panic(err)
+//	}, &pathErr) // success
+//	a.PanicAsNow(func() {
+//	  panic("some error")
+//	}, &pathErr) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicAsNow(fn func(), target any, message ...any) error {
+	a.Helper()
+
+	return tryPanicAs(a.testingT(), true, fn, target, message...)
+}
+
+// PanicMatch expects the function fn to panic, and the message of the recovered value matches the
+// regular expression pattern. If the function does not panic, or the message does not match the
+// pattern, it will set the result to fail. The message of an error is the result of its `Error`
+// method, and the message of other values is formatted by `fmt.Sprint`.
+//
+//	a := assert.New(t)
+//	a.PanicMatch(func() {
+//	  panic(fmt.Sprintf("index %d out of range", 10))
+//	}, `index \d+ out of range`) // success
+//	a.PanicMatch(func() {
+//	  panic("some error")
+//	}, `index \d+ out of range`) // fail
+func (a *Assertion) PanicMatch(fn func(), pattern string, message ...any) error {
+	a.Helper()
+
+	return tryPanicMatch(a.testingT(), false, fn, pattern, message...)
+}
+
+// PanicMatchNow expects the function fn to panic, and the message of the recovered value matches
+// the regular expression pattern. If the function does not panic, or the message does not match
+// the pattern, it will set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicMatchNow(func() {
+//	  panic(fmt.Sprintf("index %d out of range", 10))
+//	}, `index \d+ out of range`) // success
+//	a.PanicMatchNow(func() {
+//	  panic("some error")
+//	}, `index \d+ out of range`) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicMatchNow(fn func(), pattern string, message ...any) error {
+	a.Helper()
+
+	return tryPanicMatch(a.testingT(), true, fn, pattern, message...)
+}
+
+// PanicContains expects the function fn to panic, and the message of the recovered value contains
+// the substring. If the function does not panic, or the message does not contain the substring, it
+// will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicContains(func() {
+//	  panic(fmt.Sprintf("user %d not found", 42))
+//	}, "not found") // success
+//	a.PanicContains(func() {
+//	  panic("some error")
+//	}, "not found") // fail
+func (a *Assertion) PanicContains(fn func(), substr string, message ...any) error {
+	a.Helper()
+
+	return tryPanicContains(a.testingT(), false, fn, substr, message...)
+}
+
+// PanicContainsNow expects the function fn to panic, and the message of the recovered value
+// contains the substring. If the function does not panic, or the message does not contain the
+// substring, it will set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicContainsNow(func() {
+//	  panic(fmt.Sprintf("user %d not found", 42))
+//	}, "not found") // success
+//	a.PanicContainsNow(func() {
+//	  panic("some error")
+//	}, "not found") // fail and terminate
+//	// never runs
+func (a *Assertion) PanicContainsNow(fn func(), substr string, message ...any) error {
+	a.Helper()
+
+	return tryPanicContains(a.testingT(), true, fn, substr, message...)
+}
+
+// PanicWith expects the function fn to panic, and the recovered value satisfies the predicate. If
+// the function does not panic, or the predicate returns false, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicWith(func() {
+//	  panic(42)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // success
+//	a.PanicWith(func() {
+//	  panic(0)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // fail
+func (a *Assertion) PanicWith(fn func(), predicate func(v any) bool, message ...any) error {
+	a.Helper()
+
+	return tryPanicWith(a.testingT(), false, fn, predicate, message...)
+}
+
+// PanicWithNow expects the function fn to panic, and the recovered value satisfies the predicate.
+// If the function does not panic, or the predicate returns false, it will set the result to fail
+// and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicWithNow(func() {
+//	  panic(42)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // success
+//	a.PanicWithNow(func() {
+//	  panic(0)
+//	}, func(v any) bool {
+//	  return v == 42
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicWithNow(fn func(), predicate func(v any) bool, message ...any) error {
+	a.Helper()
+
+	return tryPanicWith(a.testingT(), true, fn, predicate, message...)
+}
+
+// tryPanicIs executes the function fn, and it expects the function to panic by an error that
+// matches the target error.
+func tryPanicIs(t testingT, failedNow bool, fn func(), target error, message ...any) error {
+	t.Helper()
+
+	e := isPanic(fn)
+
+	return test(
+		t,
+		func() bool {
+			err, ok := e.(error)
+			return ok && errors.Is(err, target)
+		},
+		failedNow,
+		fmt.Sprintf(defaultErrMessagePanicIs, target, formatPanicValue(e)),
+		message...,
+	)
+}
+
+// tryPanicAs executes the function fn, and it expects the function to panic by an error that
+// matches the target.
+func tryPanicAs(t testingT, failedNow bool, fn func(), target any, message ...any) error {
+	t.Helper()
+
+	e := isPanic(fn)
+
+	return test(
+		t,
+		func() bool {
+			err, ok := e.(error)
+			return ok && errors.As(err, target)
+		},
+		failedNow,
+		fmt.Sprintf(defaultErrMessagePanicAs, getTargetTypeName(target), formatPanicValue(e)),
+		message...,
+	)
+}
+
+// tryPanicMatch executes the function fn, and it expects the function to panic with a message
+// that matches the pattern.
+func tryPanicMatch(t testingT, failedNow bool, fn func(), pattern string, message ...any) error {
+	t.Helper()
+
+	re := regexp.MustCompile(pattern)
+	e := isPanic(fn)
+
+	return test(
+		t,
+		func() bool { return e != nil && re.MatchString(getPanicMessage(e)) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessagePanicMatch, pattern, formatPanicValue(e)),
+		message...,
+	)
+}
+
+// tryPanicContains executes the function fn, and it expects the function to panic with a message
+// that contains the substring.
+func tryPanicContains(
+	t testingT,
+	failedNow bool,
+	fn func(),
+	substr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	e := isPanic(fn)
+
+	return test(
+		t,
+		func() bool { return e != nil && strings.Contains(getPanicMessage(e), substr) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessagePanicContains, substr, formatPanicValue(e)),
+		message...,
+	)
+}
+
+// tryPanicWith executes the function fn, and it expects the function to panic with a value that
+// satisfies the predicate.
+func tryPanicWith(
+	t testingT,
+	failedNow bool,
+	fn func(),
+	predicate func(v any) bool,
+	message ...any,
+) error {
+	t.Helper()
+
+	e := isPanic(fn)
+
+	return test(
+		t,
+		func() bool { return e != nil && predicate(e) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessagePanicWith, formatPanicValue(e)),
+		message...,
+	)
+}
+
+// PanicRuntimeError expects the function fn to panic by a runtime error, like nil pointer
+// dereference, index out of range, or integer divide by zero. If the function does not panic, or
+// the recovered value is not a `runtime.Error`, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicRuntimeError(func() {
+//	  var m map[string]int
+//	  m["key"] = 1
+//	}) // success
+//	a.PanicRuntimeError(func() {
+//	  panic("some error")
+//	}) // fail
+func (a *Assertion) PanicRuntimeError(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(a.testingT(), false, fn, "", nil, message...)
+}
+
+// PanicRuntimeErrorNow expects the function fn to panic by a runtime error. If the function does
+// not panic, or the recovered value is not a `runtime.Error`, it will set the result to fail and
+// terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicRuntimeErrorNow(func() {
+//	  var m map[string]int
+//	  m["key"] = 1
+//	}) // success
+//	a.PanicRuntimeErrorNow(func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicRuntimeErrorNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(a.testingT(), true, fn, "", nil, message...)
+}
+
+// PanicNilDereference expects the function fn to panic by a nil pointer dereference. If the
+// function does not panic, or the recovered value is not the runtime error of the nil pointer
+// dereference, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicNilDereference(func() {
+//	  var p *int
+//	  _ = *p
+//	}) // success
+//	a.PanicNilDereference(func() {
+//	  panic("some error")
+//	}) // fail
+func (a *Assertion) PanicNilDereference(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), false, fn, runtimeErrorNilDereference, isNilDereferenceError, message...,
+	)
+}
+
+// PanicNilDereferenceNow expects the function fn to panic by a nil pointer dereference. If the
+// function does not panic, or the recovered value is not the runtime error of the nil pointer
+// dereference, it will set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicNilDereferenceNow(func() {
+//	  var p *int
+//	  _ = *p
+//	}) // success
+//	a.PanicNilDereferenceNow(func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicNilDereferenceNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), true, fn, runtimeErrorNilDereference, isNilDereferenceError, message...,
+	)
+}
+
+// PanicIndexOutOfRange expects the function fn to panic by an index out of range, including the
+// slice bounds out of range. If the function does not panic, or the recovered value is not the
+// runtime error of the index out of range, it will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicIndexOutOfRange(func() {
+//	  s := []int{}
+//	  _ = s[1]
+//	}) // success
+//	a.PanicIndexOutOfRange(func() {
+//	  panic("some error")
+//	}) // fail
+func (a *Assertion) PanicIndexOutOfRange(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), false, fn, runtimeErrorIndexOutOfRange, isIndexOutOfRangeError, message...,
+	)
+}
+
+// PanicIndexOutOfRangeNow expects the function fn to panic by an index out of range, including the
+// slice bounds out of range. If the function does not panic, or the recovered value is not the
+// runtime error of the index out of range, it will set the result to fail and terminate the
+// execution.
+//
+//	a := assert.New(t)
+//	a.PanicIndexOutOfRangeNow(func() {
+//	  s := []int{}
+//	  _ = s[1]
+//	}) // success
+//	a.PanicIndexOutOfRangeNow(func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicIndexOutOfRangeNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), true, fn, runtimeErrorIndexOutOfRange, isIndexOutOfRangeError, message...,
+	)
+}
+
+// PanicDivideByZero expects the function fn to panic by an integer divide by zero. If the function
+// does not panic, or the recovered value is not the runtime error of the integer divide by zero, it
+// will set the result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicDivideByZero(func() {
+//	  n := 0
+//	  _ = 1 / n
+//	}) // success
+//	a.PanicDivideByZero(func() {
+//	  panic("some error")
+//	}) // fail
+func (a *Assertion) PanicDivideByZero(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), false, fn, runtimeErrorDivideByZero, isDivideByZeroError, message...,
+	)
+}
+
+// PanicDivideByZeroNow expects the function fn to panic by an integer divide by zero. If the
+// function does not panic, or the recovered value is not the runtime error of the integer divide
+// by zero, it will set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicDivideByZeroNow(func() {
+//	  n := 0
+//	  _ = 1 / n
+//	}) // success
+//	a.PanicDivideByZeroNow(func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicDivideByZeroNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), true, fn, runtimeErrorDivideByZero, isDivideByZeroError, message...,
+	)
+}
+
+// PanicTypeAssertion expects the function fn to panic by a failed type assertion. If the function
+// does not panic, or the recovered value is not a `*runtime.TypeAssertionError`, it will set the
+// result to fail.
+//
+//	a := assert.New(t)
+//	a.PanicTypeAssertion(func() {
+//	  var v any = "string"
+//	  _ = v.(int)
+//	}) // success
+//	a.PanicTypeAssertion(func() {
+//	  panic("some error")
+//	}) // fail
+func (a *Assertion) PanicTypeAssertion(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), false, fn, runtimeErrorTypeAssertion, isTypeAssertionError, message...,
+	)
+}
+
+// PanicTypeAssertionNow expects the function fn to panic by a failed type assertion. If the
+// function does not panic, or the recovered value is not a `*runtime.TypeAssertionError`, it will
+// set the result to fail and terminate the execution.
+//
+//	a := assert.New(t)
+//	a.PanicTypeAssertionNow(func() {
+//	  var v any = "string"
+//	  _ = v.(int)
+//	}) // success
+//	a.PanicTypeAssertionNow(func() {
+//	  panic("some error")
+//	}) // fail and terminate
+//	// never runs
+func (a *Assertion) PanicTypeAssertionNow(fn func(), message ...any) error {
+	a.Helper()
+
+	return tryPanicRuntimeError(
+		a.testingT(), true, fn, runtimeErrorTypeAssertion, isTypeAssertionError, message...,
+	)
+}
+
+// tryPanicRuntimeError executes the function fn, and it expects the function to panic by a runtime
+// error. The runtime error will also be checked by the checker function if it is not nil, and the
+// kind is the description of the expected runtime error for the failure message.
+func tryPanicRuntimeError(
+	t testingT,
+	failedNow bool,
+	fn func(),
+	kind string,
+	checker func(runtime.Error) bool,
+	message ...any,
+) error {
+	t.Helper()
+
+	e := isPanic(fn)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessagePanicRuntimeError, formatPanicValue(e))
+	if kind != "" {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessagePanicRuntimeKind, kind, formatPanicValue(e),
+		)
+	}
+
+	return test(
+		t,
+		func() bool {
+			var runtimeErr runtime.Error
+
+			err, ok := e.(error)
+			if !ok || !errors.As(err, &runtimeErr) {
+				return false
+			}
+
+			return checker == nil || checker(runtimeErr)
+		},
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// isNilDereferenceError checks whether the runtime error is caused by a nil pointer dereference.
+func isNilDereferenceError(err runtime.Error) bool {
+	return err == nilDereferenceError
+}
+
+// isIndexOutOfRangeError checks whether the runtime error is caused by an index or a slice bounds
+// out of range.
+func isIndexOutOfRangeError(err runtime.Error) bool {
+	return reflect.TypeOf(err) == indexOutOfRangeErrorType
+}
+
+// isDivideByZeroError checks whether the runtime error is caused by an integer divide by zero.
+func isDivideByZeroError(err runtime.Error) bool {
+	return err == divideByZeroError
+}
+
+// isTypeAssertionError checks whether the runtime error is caused by a failed type assertion.
+func isTypeAssertionError(err runtime.Error) bool {
+	_, ok := err.(*runtime.TypeAssertionError)
+	return ok
+}
+
+// recoverRuntimeError executes the function fn, and returns the recovered runtime error. It
+// returns nil if the function doesn't panic by a runtime error.
+func recoverRuntimeError(fn func()) (err runtime.Error) {
+	defer func() {
+		err, _ = recover().(runtime.Error)
+	}()
+
+	fn()
+
+	return nil
+}
+
+// getPanicMessage returns the message of the recovered value. It returns the result of the
+// `Error` method for errors, and the result of `fmt.Sprint` for other values.
+func getPanicMessage(v any) string {
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+
+	return fmt.Sprint(v)
+}
+
+// formatPanicValue formats the recovered value for the failure messages, and the errors will be
+// formatted as trees with all the wrapped errors.
+func formatPanicValue(v any) string {
+	if v == nil {
+		return "no panic"
+	} else if err, ok := v.(error); ok {
+		return formatErrorTree(err)
+	}
+
+	return fmt.Sprintf("%#v", v)
+}
+
 // isPanic executes the function, and tries to catching and returns the return value from
 // recover().
 func isPanic(fn func()) (err any) {
@@ -2,6 +2,9 @@ package assert
 
 import (
 	"errors"
+	"fmt"
+	"io/fs"
+	"os"
 	"testing"
 )
 
@@ -111,3 +114,366 @@ func TestIsPanic(t *testing.T) {
 		panic("unexpected panic")
 	}))
 }
+
+func TestPanicIs(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	expectedErr := errors.New("expected error")
+
+	testPanicIs(a, mockA, func() {}, expectedErr, false)
+	testPanicIs(a, mockA, func() {
+		panic(expectedErr)
+	}, expectedErr, true)
+	testPanicIs(a, mockA, func() {
+		panic(fmt.Errorf("wrapped: %w", expectedErr))
+	}, expectedErr, true)
+	testPanicIs(a, mockA, func() {
+		panic(errors.New("expected error"))
+	}, expectedErr, false)
+	testPanicIs(a, mockA, func() {
+		panic("expected error")
+	}, expectedErr, false)
+}
+
+func testPanicIs(a, mockA *Assertion, fn func(), target error, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicIs", func() error {
+		return PanicIs(mockA.T, fn, target)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicIs", func() error {
+		return mockA.PanicIs(fn, target)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicIsNow", func() {
+		PanicIsNow(mockA.T, fn, target)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicIsNow", func() {
+		mockA.PanicIsNow(fn, target)
+	}, !isOk)
+}
+
+func TestPanicAs(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	_, pathErr := os.Open("not-exist-file")
+
+	testPanicAs(a, mockA, func() {}, false)
+	testPanicAs(a, mockA, func() {
+		panic(pathErr)
+	}, true)
+	testPanicAs(a, mockA, func() {
+		panic(fmt.Errorf("wrapped: %w", pathErr))
+	}, true)
+	testPanicAs(a, mockA, func() {
+		panic(errors.New("some error"))
+	}, false)
+	testPanicAs(a, mockA, func() {
+		panic("some error")
+	}, false)
+
+	var target *fs.PathError
+	a.NilNow(mockA.PanicAs(func() {
+		panic(pathErr)
+	}, &target))
+	a.NotNilNow(target)
+	a.EqualNow(target.Path, "not-exist-file")
+}
+
+func testPanicAs(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	var target *fs.PathError
+
+	testAssertionFunction(a, "PanicAs", func() error {
+		return PanicAs(mockA.T, fn, &target)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicAs", func() error {
+		return mockA.PanicAs(fn, &target)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicAsNow", func() {
+		PanicAsNow(mockA.T, fn, &target)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicAsNow", func() {
+		mockA.PanicAsNow(fn, &target)
+	}, !isOk)
+}
+
+func TestPanicMatchAndPanicContains(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testPanicMatchAndPanicContains(a, mockA, func() {}, `.*`, "", false)
+	testPanicMatchAndPanicContains(a, mockA, func() {
+		panic(fmt.Sprintf("user %d not found", 42))
+	}, `^user \d+ not found$`, "not found", true)
+	testPanicMatchAndPanicContains(a, mockA, func() {
+		panic(fmt.Errorf("load: %w", errors.New("user 42 not found")))
+	}, `user \d+ not found$`, "not found", true)
+	testPanicMatchAndPanicContains(a, mockA, func() {
+		panic(42)
+	}, `^42$`, "42", true)
+	testPanicMatchAndPanicContains(a, mockA, func() {
+		panic("some error")
+	}, `not found`, "not found", false)
+
+	a.PanicNow(func() {
+		mockA.PanicMatch(func() {}, `(`)
+	})
+}
+
+func testPanicMatchAndPanicContains(
+	a, mockA *Assertion,
+	fn func(),
+	pattern, substr string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicMatch", func() error {
+		return PanicMatch(mockA.T, fn, pattern)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicMatch", func() error {
+		return mockA.PanicMatch(fn, pattern)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicMatchNow", func() {
+		PanicMatchNow(mockA.T, fn, pattern)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicMatchNow", func() {
+		mockA.PanicMatchNow(fn, pattern)
+	}, !isOk)
+
+	testAssertionFunction(a, "PanicContains", func() error {
+		return PanicContains(mockA.T, fn, substr)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicContains", func() error {
+		return mockA.PanicContains(fn, substr)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicContainsNow", func() {
+		PanicContainsNow(mockA.T, fn, substr)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicContainsNow", func() {
+		mockA.PanicContainsNow(fn, substr)
+	}, !isOk)
+}
+
+func TestPanicWith(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	isAnswer := func(v any) bool {
+		return v == 42
+	}
+
+	testPanicWith(a, mockA, func() {}, isAnswer, false)
+	testPanicWith(a, mockA, func() {
+		panic(42)
+	}, isAnswer, true)
+	testPanicWith(a, mockA, func() {
+		panic(0)
+	}, isAnswer, false)
+	testPanicWith(a, mockA, func() {
+		panic("42")
+	}, isAnswer, false)
+}
+
+func testPanicWith(a, mockA *Assertion, fn func(), predicate func(v any) bool, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicWith", func() error {
+		return PanicWith(mockA.T, fn, predicate)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicWith", func() error {
+		return mockA.PanicWith(fn, predicate)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicWithNow", func() {
+		PanicWithNow(mockA.T, fn, predicate)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicWithNow", func() {
+		mockA.PanicWithNow(fn, predicate)
+	}, !isOk)
+}
+
+func TestFormatPanicValue(t *testing.T) {
+	a := New(t)
+
+	err := errors.New("some error")
+
+	a.EqualNow(formatPanicValue(nil), "no panic")
+	a.EqualNow(formatPanicValue("some error"), "\"some error\"")
+	a.EqualNow(formatPanicValue(42), "42")
+	a.EqualNow(formatPanicValue(err), formatErrorTree(err))
+}
+
+func TestPanicRuntimeErrors(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	nilDereference := func() {
+		var p *int
+		_ = *p
+	}
+	indexOutOfRange := func() {
+		s := []int{}
+		i := 1
+		_ = s[i]
+	}
+	sliceOutOfRange := func() {
+		s := []int{}
+		i := 1
+		_ = s[i:]
+	}
+	divideByZero := func() {
+		n := 0
+		_ = 1 / n
+	}
+	typeAssertion := func() {
+		var v any = "string"
+		_ = v.(int)
+	}
+	wrappedRuntimeError := func() {
+		defer func() {
+			panic(fmt.Errorf("wrapped: %w", recover().(error)))
+		}()
+		divideByZero()
+	}
+
+	testPanicRuntimeError(a, mockA, func() {}, false)
+	testPanicRuntimeError(a, mockA, func() { panic("runtime error") }, false)
+	testPanicRuntimeError(a, mockA, func() { panic(errors.New("runtime error")) }, false)
+	testPanicRuntimeError(a, mockA, nilDereference, true)
+	testPanicRuntimeError(a, mockA, typeAssertion, true)
+	testPanicRuntimeError(a, mockA, wrappedRuntimeError, true)
+
+	testPanicNilDereference(a, mockA, func() {}, false)
+	testPanicNilDereference(a, mockA, nilDereference, true)
+	testPanicNilDereference(a, mockA, indexOutOfRange, false)
+
+	testPanicIndexOutOfRange(a, mockA, func() {}, false)
+	testPanicIndexOutOfRange(a, mockA, indexOutOfRange, true)
+	testPanicIndexOutOfRange(a, mockA, sliceOutOfRange, true)
+	testPanicIndexOutOfRange(a, mockA, divideByZero, false)
+
+	testPanicDivideByZero(a, mockA, func() {}, false)
+	testPanicDivideByZero(a, mockA, divideByZero, true)
+	testPanicDivideByZero(a, mockA, wrappedRuntimeError, true)
+	testPanicDivideByZero(a, mockA, func() { panic("integer divide by zero") }, false)
+	testPanicDivideByZero(a, mockA, typeAssertion, false)
+	testPanicDivideByZero(a, mockA, func() { panic(fakeRuntimeError("integer divide by zero")) }, false)
+
+	testPanicTypeAssertion(a, mockA, func() {}, false)
+	testPanicTypeAssertion(a, mockA, typeAssertion, true)
+	testPanicTypeAssertion(a, mockA, nilDereference, false)
+}
+
+func TestRecoveredRuntimeErrors(t *testing.T) {
+	a := New(t)
+
+	a.NotNilNow(nilDereferenceError)
+	a.NotNilNow(divideByZeroError)
+	a.NotNilNow(indexOutOfRangeErrorType)
+	a.NilNow(recoverRuntimeError(func() {}))
+
+	a.TrueNow(isNilDereferenceError(recoverRuntimeError(func() {
+		var m *struct{ v int }
+		_ = m.v
+	})))
+	a.NotTrueNow(isNilDereferenceError(fakeRuntimeError("nil pointer dereference")))
+	a.NotTrueNow(isIndexOutOfRangeError(fakeRuntimeError("index out of range")))
+	a.NotTrueNow(isDivideByZeroError(fakeRuntimeError("integer divide by zero")))
+}
+
+// fakeRuntimeError is a runtime error with the message of a real runtime error.
+type fakeRuntimeError string
+
+func (e fakeRuntimeError) Error() string {
+	return "runtime error: " + string(e)
+}
+
+func (e fakeRuntimeError) RuntimeError() {}
+
+func testPanicRuntimeError(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicRuntimeError", func() error {
+		return PanicRuntimeError(mockA.T, fn)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicRuntimeError", func() error {
+		return mockA.PanicRuntimeError(fn)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicRuntimeErrorNow", func() {
+		PanicRuntimeErrorNow(mockA.T, fn)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicRuntimeErrorNow", func() {
+		mockA.PanicRuntimeErrorNow(fn)
+	}, !isOk)
+}
+
+func testPanicNilDereference(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicNilDereference", func() error {
+		return PanicNilDereference(mockA.T, fn)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicNilDereference", func() error {
+		return mockA.PanicNilDereference(fn)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicNilDereferenceNow", func() {
+		PanicNilDereferenceNow(mockA.T, fn)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicNilDereferenceNow", func() {
+		mockA.PanicNilDereferenceNow(fn)
+	}, !isOk)
+}
+
+func testPanicIndexOutOfRange(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicIndexOutOfRange", func() error {
+		return PanicIndexOutOfRange(mockA.T, fn)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicIndexOutOfRange", func() error {
+		return mockA.PanicIndexOutOfRange(fn)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicIndexOutOfRangeNow", func() {
+		PanicIndexOutOfRangeNow(mockA.T, fn)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicIndexOutOfRangeNow", func() {
+		mockA.PanicIndexOutOfRangeNow(fn)
+	}, !isOk)
+}
+
+func testPanicDivideByZero(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicDivideByZero", func() error {
+		return PanicDivideByZero(mockA.T, fn)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicDivideByZero", func() error {
+		return mockA.PanicDivideByZero(fn)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicDivideByZeroNow", func() {
+		PanicDivideByZeroNow(mockA.T, fn)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicDivideByZeroNow", func() {
+		mockA.PanicDivideByZeroNow(fn)
+	}, !isOk)
+}
+
+func testPanicTypeAssertion(a, mockA *Assertion, fn func(), isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "PanicTypeAssertion", func() error {
+		return PanicTypeAssertion(mockA.T, fn)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.PanicTypeAssertion", func() error {
+		return mockA.PanicTypeAssertion(fn)
+	}, isOk)
+	testAssertionNowFunction(a, "PanicTypeAssertionNow", func() {
+		PanicTypeAssertionNow(mockA.T, fn)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.PanicTypeAssertionNow", func() {
+		mockA.PanicTypeAssertionNow(fn)
+	}, !isOk)
+}
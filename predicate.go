@@ -0,0 +1,369 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+var (
+	assertionPtrType = reflect.TypeOf((*Assertion)(nil))
+)
+
+// ContainsFunc tests whether the array, slice, or the values of the map contain at least one
+// element that satisfies the predicate, and it set the result to fail if no element satisfies the
+// predicate. The predicate can be a function like `func(e T) bool`, or an assertion block like
+// `func(a *assert.Assertion, e T)` that is satisfied if no assertion in the block failed.
+//
+//	a := assert.New(t)
+//	a.ContainsFunc([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	a.ContainsFunc([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail
+func (a *Assertion) ContainsFunc(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAnyMatch(a.testingT(), false, source, fn, message...)
+}
+
+// ContainsFuncNow tests whether the array, slice, or the values of the map contain at least one
+// element that satisfies the predicate, and it will terminate the execution if no element
+// satisfies the predicate.
+//
+//	a := assert.New(t)
+//	a.ContainsFuncNow([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	a.ContainsFuncNow([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsFuncNow(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAnyMatch(a.testingT(), true, source, fn, message...)
+}
+
+// AllMatch tests whether every element of the array, slice, or the values of the map satisfies
+// the predicate, and it set the result to fail if any element does not satisfy the predicate. The
+// indices (or the keys for a map) of the mismatched elements will be reported in the message.
+//
+//	a := assert.New(t)
+//	a.AllMatch([]int{1, 2, 3}, func(n int) bool { return n > 0 }) // success
+//	a.AllMatch([]int{1, 2, 3}, func(a *assert.Assertion, n int) {
+//	  a.Gt(n, 0)
+//	}) // success
+//	a.AllMatch([]int{1, 2, 3}, func(n int) bool { return n > 1 }) // fail
+func (a *Assertion) AllMatch(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAllMatch(a.testingT(), false, source, fn, message...)
+}
+
+// AllMatchNow tests whether every element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if any element does not satisfy the
+// predicate.
+//
+//	a := assert.New(t)
+//	a.AllMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 0 }) // success
+//	a.AllMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 1 }) // fail and terminate
+//	// never runs
+func (a *Assertion) AllMatchNow(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAllMatch(a.testingT(), true, source, fn, message...)
+}
+
+// AnyMatch tests whether any element of the array, slice, or the values of the map satisfies the
+// predicate, and it set the result to fail if no element satisfies the predicate.
+//
+//	a := assert.New(t)
+//	a.AnyMatch([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	a.AnyMatch([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail
+func (a *Assertion) AnyMatch(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAnyMatch(a.testingT(), false, source, fn, message...)
+}
+
+// AnyMatchNow tests whether any element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if no element satisfies the predicate.
+//
+//	a := assert.New(t)
+//	a.AnyMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // success
+//	a.AnyMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // fail and terminate
+//	// never runs
+func (a *Assertion) AnyMatchNow(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryAnyMatch(a.testingT(), true, source, fn, message...)
+}
+
+// NoneMatch tests whether no element of the array, slice, or the values of the map satisfies the
+// predicate, and it set the result to fail if any element satisfies the predicate. The indices (or
+// the keys for a map) of the matched elements will be reported in the message.
+//
+//	a := assert.New(t)
+//	a.NoneMatch([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // success
+//	a.NoneMatch([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // fail
+func (a *Assertion) NoneMatch(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryNoneMatch(a.testingT(), false, source, fn, message...)
+}
+
+// NoneMatchNow tests whether no element of the array, slice, or the values of the map satisfies
+// the predicate, and it will terminate the execution if any element satisfies the predicate.
+//
+//	a := assert.New(t)
+//	a.NoneMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 3 }) // success
+//	a.NoneMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 2 }) // fail and terminate
+//	// never runs
+func (a *Assertion) NoneMatchNow(source, fn any, message ...any) error {
+	a.Helper()
+
+	return tryNoneMatch(a.testingT(), true, source, fn, message...)
+}
+
+// CountMatch tests whether the number of elements of the array, slice, or the values of the map
+// that satisfy the predicate equals to n, and it set the result to fail if the number is not n.
+//
+//	a := assert.New(t)
+//	a.CountMatch([]int{1, 2, 3}, func(n int) bool { return n > 1 }, 2) // success
+//	a.CountMatch([]int{1, 2, 3}, func(n int) bool { return n > 1 }, 1) // fail
+func (a *Assertion) CountMatch(source, fn any, n int, message ...any) error {
+	a.Helper()
+
+	return tryCountMatch(a.testingT(), false, source, fn, n, message...)
+}
+
+// CountMatchNow tests whether the number of elements of the array, slice, or the values of the
+// map that satisfy the predicate equals to n, and it will terminate the execution if the number is
+// not n.
+//
+//	a := assert.New(t)
+//	a.CountMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 1 }, 2) // success
+//	a.CountMatchNow([]int{1, 2, 3}, func(n int) bool { return n > 1 }, 1) // fail and terminate
+//	// never runs
+func (a *Assertion) CountMatchNow(source, fn any, n int, message ...any) error {
+	a.Helper()
+
+	return tryCountMatch(a.testingT(), true, source, fn, n, message...)
+}
+
+// tryAllMatch tries to test whether all elements of the source satisfy the predicate or not, and
+// it'll fail if any element does not satisfy the predicate.
+func tryAllMatch(t testingT, failedNow bool, source, fn any, message ...any) error {
+	t.Helper()
+
+	_, mismatched, reasons := matchElements(t, source, fn)
+
+	defaultMessage := ""
+	if len(mismatched) > 0 {
+		defaultMessage = fmt.Sprintf(defaultErrMessageAllMatch, mismatched) +
+			formatMismatchReason(mismatched, reasons)
+	}
+
+	return test(
+		t,
+		func() bool { return len(mismatched) == 0 },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryAnyMatch tries to test whether any element of the source satisfies the predicate or not, and
+// it'll fail if no element satisfies the predicate.
+func tryAnyMatch(t testingT, failedNow bool, source, fn any, message ...any) error {
+	t.Helper()
+
+	matched, mismatched, reasons := matchElements(t, source, fn)
+
+	defaultMessage := ""
+	if len(matched) == 0 {
+		defaultMessage = defaultErrMessageAnyMatch + formatMismatchReason(mismatched, reasons)
+	}
+
+	return test(
+		t,
+		func() bool { return len(matched) > 0 },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryNoneMatch tries to test whether no element of the source satisfies the predicate or not, and
+// it'll fail if any element satisfies the predicate.
+func tryNoneMatch(t testingT, failedNow bool, source, fn any, message ...any) error {
+	t.Helper()
+
+	matched, _, _ := matchElements(t, source, fn)
+
+	return test(
+		t,
+		func() bool { return len(matched) == 0 },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageNoneMatch, matched),
+		message...,
+	)
+}
+
+// tryCountMatch tries to test whether the number of elements of the source that satisfy the
+// predicate equals to n or not, and it'll fail if the number does not equal to n.
+func tryCountMatch(t testingT, failedNow bool, source, fn any, n int, message ...any) error {
+	t.Helper()
+
+	matched, _, _ := matchElements(t, source, fn)
+
+	return test(
+		t,
+		func() bool { return len(matched) == n },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageCountMatch, n, len(matched), matched),
+		message...,
+	)
+}
+
+// matchElements tests every element of the array, slice, or the values of the map with the
+// predicate, and returns the indices (or keys for a map) of the matched elements and the
+// mismatched elements, and the failure messages of the assertion blocks for the mismatched
+// elements. The keys of a map are sorted in their natural order. It'll panic if the source is not
+// an array, a slice, or a map, or the predicate is not a valid predicate function for the elements.
+func matchElements(t testingT, source, fn any) (matched, mismatched []any, reasons []string) {
+	sv := reflect.ValueOf(source)
+	if sv.Kind() == reflect.Ptr {
+		sv = sv.Elem()
+	}
+
+	var keys []any
+	var elems []reflect.Value
+
+	switch sv.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < sv.Len(); i++ {
+			keys = append(keys, i)
+			elems = append(elems, sv.Index(i))
+		}
+	case reflect.Map:
+		mapKeys := sv.MapKeys()
+		sort.SliceStable(mapKeys, func(i, j int) bool {
+			return isMapKeyLess(mapKeys[i], mapKeys[j])
+		})
+		for _, key := range mapKeys {
+			keys = append(keys, key.Interface())
+			elems = append(elems, sv.MapIndex(key))
+		}
+	default:
+		panic(ErrNotCollection)
+	}
+
+	fv := reflect.ValueOf(fn)
+	checkPredicate(fv, sv.Type().Elem())
+
+	matched = make([]any, 0)
+	mismatched = make([]any, 0)
+	reasons = make([]string, 0)
+
+	for i, elem := range elems {
+		if ok, reason := isElementMatch(t, fv, elem); ok {
+			matched = append(matched, keys[i])
+		} else {
+			mismatched = append(mismatched, keys[i])
+			reasons = append(reasons, reason)
+		}
+	}
+
+	return
+}
+
+// isMapKeyLess reports whether the map key x should sort before the map key y. The keys of the
+// numbers, the strings, and the booleans are compared by their values, the keys of different kinds
+// are compared by their kinds, and the other keys are compared by their formatted strings.
+func isMapKeyLess(x, y reflect.Value) bool {
+	if x.Kind() == reflect.Interface && !x.IsNil() {
+		x = x.Elem()
+	}
+	if y.Kind() == reflect.Interface && !y.IsNil() {
+		y = y.Elem()
+	}
+
+	if x.Kind() != y.Kind() {
+		return x.Kind() < y.Kind()
+	}
+
+	switch x.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return x.Int() < y.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr:
+		return x.Uint() < y.Uint()
+	case reflect.Float32, reflect.Float64:
+		return x.Float() < y.Float()
+	case reflect.String:
+		return x.String() < y.String()
+	case reflect.Bool:
+		return !x.Bool() && y.Bool()
+	default:
+		return fmt.Sprint(x) < fmt.Sprint(y)
+	}
+}
+
+// formatMismatchReason formats the failure message of the assertion block for the first
+// mismatched element, and it returns an empty string if there is no such message.
+func formatMismatchReason(mismatched []any, reasons []string) string {
+	if len(reasons) == 0 || reasons[0] == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(defaultErrMessageMismatchReason, mismatched[0], reasons[0])
+}
+
+// checkPredicate checks whether the function is a predicate like `func(e T) bool` or an
+// assertion block like `func(a *Assertion, e T)`, and the elements can be passed to it. It'll
+// panic if the function is not a valid predicate.
+func checkPredicate(fn reflect.Value, elemType reflect.Type) {
+	if fn.Kind() != reflect.Func || fn.IsNil() {
+		panic(ErrInvalidPredicate)
+	}
+
+	ft := fn.Type()
+	var in reflect.Type
+
+	switch {
+	case ft.NumIn() == 1 && ft.NumOut() == 1 && ft.Out(0).Kind() == reflect.Bool:
+		in = ft.In(0)
+	case ft.NumIn() == 2 && ft.NumOut() == 0 && ft.In(0) == assertionPtrType:
+		in = ft.In(1)
+	default:
+		panic(ErrInvalidPredicate)
+	}
+
+	if !elemType.AssignableTo(in) && elemType.Kind() != reflect.Interface {
+		panic(ErrInvalidPredicate)
+	}
+}
+
+// isElementMatch calls the predicate with the element, and returns whether the element satisfies
+// the predicate or not, and the failure message of the assertion block if it is not satisfied. For
+// the elements of interface types, it'll return false if the dynamic value cannot be passed to the
+// predicate.
+func isElementMatch(t testingT, fn reflect.Value, elem reflect.Value) (bool, string) {
+	ft := fn.Type()
+	in := ft.In(ft.NumIn() - 1)
+
+	if !elem.Type().AssignableTo(in) {
+		if elem.Kind() != reflect.Interface || elem.IsNil() || !elem.Elem().Type().AssignableTo(in) {
+			return false, ""
+		}
+		elem = elem.Elem()
+	}
+
+	if ft.NumIn() == 1 {
+		return fn.Call([]reflect.Value{elem})[0].Bool(), ""
+	}
+
+	err := runAssertionBlock(t, func(a *Assertion) {
+		fn.Call([]reflect.Value{reflect.ValueOf(a), elem})
+	})
+	if err != nil {
+		return false, err.Error()
+	}
+
+	return true, ""
+}
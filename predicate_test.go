@@ -0,0 +1,187 @@
+package assert
+
+import (
+	"testing"
+)
+
+func TestContainsFuncAndAnyMatch(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	isPositive := func(n int) bool { return n > 0 }
+	isGtTwo := func(a *Assertion, n int) { a.GtNow(n, 2) }
+
+	testContainsFuncAndAnyMatch(a, mockA, []int{1, 2, 3}, isPositive, true)
+	testContainsFuncAndAnyMatch(a, mockA, []int{-1, -2, 3}, isPositive, true)
+	testContainsFuncAndAnyMatch(a, mockA, []int{-1, -2, -3}, isPositive, false)
+	testContainsFuncAndAnyMatch(a, mockA, []int{}, isPositive, false)
+	testContainsFuncAndAnyMatch(a, mockA, [3]int{-1, 0, 1}, isPositive, true)
+	testContainsFuncAndAnyMatch(a, mockA, map[string]int{"a": -1, "b": 1}, isPositive, true)
+	testContainsFuncAndAnyMatch(a, mockA, map[string]int{"a": -1}, isPositive, false)
+	testContainsFuncAndAnyMatch(a, mockA, []int{1, 2, 3}, isGtTwo, true)
+	testContainsFuncAndAnyMatch(a, mockA, []int{1, 2}, isGtTwo, false)
+}
+
+func testContainsFuncAndAnyMatch(a, mockA *Assertion, source, fn any, isMatch bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "ContainsFunc", func() error {
+		return ContainsFunc(mockA.T, source, fn)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.ContainsFunc", func() error {
+		return mockA.ContainsFunc(source, fn)
+	}, isMatch)
+	testAssertionNowFunction(a, "ContainsFuncNow", func() {
+		ContainsFuncNow(mockA.T, source, fn)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.ContainsFuncNow", func() {
+		mockA.ContainsFuncNow(source, fn)
+	}, !isMatch)
+
+	testAssertionFunction(a, "AnyMatch", func() error {
+		return AnyMatch(mockA.T, source, fn)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.AnyMatch", func() error {
+		return mockA.AnyMatch(source, fn)
+	}, isMatch)
+	testAssertionNowFunction(a, "AnyMatchNow", func() {
+		AnyMatchNow(mockA.T, source, fn)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.AnyMatchNow", func() {
+		mockA.AnyMatchNow(source, fn)
+	}, !isMatch)
+
+	testAssertionFunction(a, "NoneMatch", func() error {
+		return NoneMatch(mockA.T, source, fn)
+	}, !isMatch)
+	testAssertionFunction(a, "Assertion.NoneMatch", func() error {
+		return mockA.NoneMatch(source, fn)
+	}, !isMatch)
+	testAssertionNowFunction(a, "NoneMatchNow", func() {
+		NoneMatchNow(mockA.T, source, fn)
+	}, isMatch)
+	testAssertionNowFunction(a, "Assertion.NoneMatchNow", func() {
+		mockA.NoneMatchNow(source, fn)
+	}, isMatch)
+}
+
+func TestAllMatch(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	isPositive := func(n int) bool { return n > 0 }
+	isGtTwo := func(a *Assertion, n int) { a.Gt(n, 2) }
+
+	testAllMatch(a, mockA, []int{1, 2, 3}, isPositive, true)
+	testAllMatch(a, mockA, []int{-1, 2, 3}, isPositive, false)
+	testAllMatch(a, mockA, []int{}, isPositive, true)
+	testAllMatch(a, mockA, &[]int{1, 2, 3}, isPositive, true)
+	testAllMatch(a, mockA, map[string]int{"a": 1, "b": 2}, isPositive, true)
+	testAllMatch(a, mockA, map[string]int{"a": 1, "b": -2}, isPositive, false)
+	testAllMatch(a, mockA, []int{3, 4}, isGtTwo, true)
+	testAllMatch(a, mockA, []int{2, 3}, isGtTwo, false)
+	testAllMatch(a, mockA, []any{1, 2}, isPositive, true)
+	testAllMatch(a, mockA, []any{1, "2"}, isPositive, false)
+}
+
+func testAllMatch(a, mockA *Assertion, source, fn any, isMatch bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "AllMatch", func() error {
+		return AllMatch(mockA.T, source, fn)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.AllMatch", func() error {
+		return mockA.AllMatch(source, fn)
+	}, isMatch)
+	testAssertionNowFunction(a, "AllMatchNow", func() {
+		AllMatchNow(mockA.T, source, fn)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.AllMatchNow", func() {
+		mockA.AllMatchNow(source, fn)
+	}, !isMatch)
+}
+
+func TestCountMatch(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	isPositive := func(n int) bool { return n > 0 }
+
+	testCountMatch(a, mockA, []int{1, 2, 3}, isPositive, 3, true)
+	testCountMatch(a, mockA, []int{-1, 2, 3}, isPositive, 2, true)
+	testCountMatch(a, mockA, []int{-1, 2, 3}, isPositive, 3, false)
+	testCountMatch(a, mockA, []int{}, isPositive, 0, true)
+	testCountMatch(a, mockA, map[string]int{"a": 1, "b": -2}, isPositive, 1, true)
+}
+
+func testCountMatch(a, mockA *Assertion, source, fn any, n int, isMatch bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "CountMatch", func() error {
+		return CountMatch(mockA.T, source, fn, n)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.CountMatch", func() error {
+		return mockA.CountMatch(source, fn, n)
+	}, isMatch)
+	testAssertionNowFunction(a, "CountMatchNow", func() {
+		CountMatchNow(mockA.T, source, fn, n)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.CountMatchNow", func() {
+		mockA.CountMatchNow(source, fn, n)
+	}, !isMatch)
+}
+
+func TestMatchElements(t *testing.T) {
+	a := New(t)
+
+	isPositive := func(n int) bool { return n > 0 }
+
+	a.PanicOfNow(func() {
+		matchElements(a.T, "not a collection", isPositive)
+	}, ErrNotCollection)
+	a.PanicOfNow(func() {
+		matchElements(a.T, []int{1}, "not a function")
+	}, ErrInvalidPredicate)
+	a.PanicOfNow(func() {
+		matchElements(a.T, []int{1}, func(s string) bool { return s != "" })
+	}, ErrInvalidPredicate)
+	a.PanicOfNow(func() {
+		matchElements(a.T, []int{1}, func(n int) int { return n })
+	}, ErrInvalidPredicate)
+
+	matched, mismatched, reasons := matchElements(a.T, []int{-1, 1, -2, 2}, isPositive)
+	a.DeepEqualNow(matched, []any{1, 3})
+	a.DeepEqualNow(mismatched, []any{0, 2})
+	a.DeepEqualNow(reasons, []string{"", ""})
+
+	matched, mismatched, _ = matchElements(a.T, map[string]int{"b": 1, "a": 2, "c": -1}, isPositive)
+	a.DeepEqualNow(matched, []any{"a", "b"})
+	a.DeepEqualNow(mismatched, []any{"c"})
+
+	matched, mismatched, _ = matchElements(a.T, map[int]int{10: 1, 2: -1, 1: -1, -3: 1}, isPositive)
+	a.DeepEqualNow(matched, []any{-3, 10})
+	a.DeepEqualNow(mismatched, []any{1, 2})
+
+	matched, _, _ = matchElements(a.T, map[any]int{"a": 1, 10: 1, 2: 1, true: 1}, isPositive)
+	a.DeepEqualNow(matched, []any{true, 2, 10, "a"})
+
+	err := New(new(testing.T)).AllMatch([]int{-1, 1, -2}, isPositive)
+	a.EqualNow(err.Error(), "assert error: expect all elements match the predicate, mismatched at [0 2]")
+
+	err = New(new(testing.T)).AllMatch(map[int]int{10: 3, 2: 1, 1: 2}, func(a *Assertion, n int) {
+		a.GtNow(n, 2)
+	})
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect all elements match the predicate, mismatched at [1 2]\n"+
+			"mismatched at 1: assert error: 2 must greater than 2",
+	)
+
+	err = New(new(testing.T)).ContainsFunc([]int{1, 2}, func(a *Assertion, n int) {
+		a.EqualNow(n, 3, "%d is too small", n)
+	})
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect any element matches the predicate\nmismatched at 0: 1 is too small",
+	)
+}
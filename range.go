@@ -0,0 +1,265 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Between tests whether the value is between the lower bound and the upper bound (both
+// inclusive), and it set the result to fail if the value is not in the range. It supports all
+// values that can be compared by the comparison assertions like Gt and Lt, and it'll panic if the
+// values are not the same type or not orderable.
+//
+//	a := assert.New(t)
+//	a.Between(42, 0, 100) // success
+//	a.Between(40, 0, 40) // success
+//	a.Between(42, 0, 40) // fail: 42 not in [0, 40]
+func (a *Assertion) Between(v, lo, hi any, message ...any) error {
+	a.Helper()
+
+	return tryBetween(a.testingT(), false, v, lo, hi, compareTypeEqual, message...)
+}
+
+// BetweenNow tests whether the value is between the lower bound and the upper bound (both
+// inclusive), and it will terminate the execution if the value is not in the range.
+//
+//	a := assert.New(t)
+//	a.BetweenNow(42, 0, 100) // success
+//	a.BetweenNow(42, 0, 40) // fail and terminate
+//	// never runs
+func (a *Assertion) BetweenNow(v, lo, hi any, message ...any) error {
+	a.Helper()
+
+	return tryBetween(a.testingT(), true, v, lo, hi, compareTypeEqual, message...)
+}
+
+// BetweenExclusive tests whether the value is between the lower bound and the upper bound (both
+// exclusive), and it set the result to fail if the value is not in the range.
+//
+//	a := assert.New(t)
+//	a.BetweenExclusive(42, 0, 100) // success
+//	a.BetweenExclusive(40, 0, 40) // fail: 40 not in (0, 40)
+func (a *Assertion) BetweenExclusive(v, lo, hi any, message ...any) error {
+	a.Helper()
+
+	return tryBetween(a.testingT(), false, v, lo, hi, 0, message...)
+}
+
+// BetweenExclusiveNow tests whether the value is between the lower bound and the upper bound
+// (both exclusive), and it will terminate the execution if the value is not in the range.
+//
+//	a := assert.New(t)
+//	a.BetweenExclusiveNow(42, 0, 100) // success
+//	a.BetweenExclusiveNow(40, 0, 40) // fail and terminate
+//	// never runs
+func (a *Assertion) BetweenExclusiveNow(v, lo, hi any, message ...any) error {
+	a.Helper()
+
+	return tryBetween(a.testingT(), true, v, lo, hi, 0, message...)
+}
+
+// InDelta tests whether the difference between the actual value and the expected value is not
+// greater than the delta, and it set the result to fail if the difference is greater than the
+// delta. It supports integers, durations, and floating numbers, and the values and the delta must
+// be the same type.
+//
+//	a := assert.New(t)
+//	a.InDelta(42, 40, 2) // success
+//	a.InDelta(time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	a.InDelta(42, 40, 1) // fail
+func (a *Assertion) InDelta(actual, expect, delta any, message ...any) error {
+	a.Helper()
+
+	return tryInDelta(a.testingT(), false, actual, expect, delta, message...)
+}
+
+// InDeltaNow tests whether the difference between the actual value and the expected value is not
+// greater than the delta, and it will terminate the execution if the difference is greater than
+// the delta.
+//
+//	a := assert.New(t)
+//	a.InDeltaNow(42, 40, 2) // success
+//	a.InDeltaNow(42, 40, 1) // fail and terminate
+//	// never runs
+func (a *Assertion) InDeltaNow(actual, expect, delta any, message ...any) error {
+	a.Helper()
+
+	return tryInDelta(a.testingT(), true, actual, expect, delta, message...)
+}
+
+// InEpsilonPercent tests whether the relative difference between the actual value and the
+// expected value is not greater than the percentage of the expected value, and it set the result
+// to fail if the relative difference is greater than the percentage.
+//
+//	a := assert.New(t)
+//	a.InEpsilonPercent(105, 100, 5) // success
+//	a.InEpsilonPercent(1100*time.Millisecond, time.Second, 10) // success
+//	a.InEpsilonPercent(106, 100, 5) // fail
+func (a *Assertion) InEpsilonPercent(actual, expect any, percent float64, message ...any) error {
+	a.Helper()
+
+	return tryInEpsilonPercent(a.testingT(), false, actual, expect, percent, message...)
+}
+
+// InEpsilonPercentNow tests whether the relative difference between the actual value and the
+// expected value is not greater than the percentage of the expected value, and it will terminate
+// the execution if the relative difference is greater than the percentage.
+//
+//	a := assert.New(t)
+//	a.InEpsilonPercentNow(105, 100, 5) // success
+//	a.InEpsilonPercentNow(106, 100, 5) // fail and terminate
+//	// never runs
+func (a *Assertion) InEpsilonPercentNow(actual, expect any, percent float64, message ...any) error {
+	a.Helper()
+
+	return tryInEpsilonPercent(a.testingT(), true, actual, expect, percent, message...)
+}
+
+// tryBetween tries to test whether the value is in the range or not, and it'll fail if the value
+// is out of the range. The bounds are inclusive if the boundType is compareTypeEqual.
+func tryBetween(
+	t testingT,
+	failedNow bool,
+	v, lo, hi any,
+	boundType uint,
+	message ...any,
+) error {
+	t.Helper()
+
+	vv := reflect.ValueOf(v)
+	lov := reflect.ValueOf(lo)
+	hiv := reflect.ValueOf(hi)
+
+	if !vv.IsValid() {
+		panic(ErrNotOrderable)
+	} else if !lov.IsValid() || !hiv.IsValid() ||
+		!isSameType(vv.Type(), lov.Type()) || !isSameType(vv.Type(), hiv.Type()) {
+		panic(ErrNotSameType)
+	} else if !isOrderable(v) {
+		panic(ErrNotOrderable)
+	}
+
+	format := defaultErrMessageBetweenExclusive
+	if boundType == compareTypeEqual {
+		format = defaultErrMessageBetween
+	}
+
+	return test(
+		t,
+		func() bool {
+			return compareValues(vv, lov, compareTypeGreater|boundType) &&
+				compareValues(vv, hiv, compareTypeLess|boundType)
+		},
+		failedNow,
+		fmt.Sprintf(format, formatValue(v), formatValue(lo), formatValue(hi)),
+		message...,
+	)
+}
+
+// tryInDelta tries to test whether the difference between the values is not greater than the
+// delta, and it'll fail if the difference is greater than the delta.
+func tryInDelta(t testingT, failedNow bool, actual, expect, delta any, message ...any) error {
+	t.Helper()
+
+	ok, diff := isInDelta(actual, expect, delta)
+
+	return test(
+		t,
+		func() bool { return ok },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageInDelta,
+			formatValue(actual), formatValue(expect), formatValue(diff), formatValue(delta),
+		),
+		message...,
+	)
+}
+
+// tryInEpsilonPercent tries to test whether the relative difference between the values is not
+// greater than the percentage, and it'll fail if the relative difference is greater than the
+// percentage.
+func tryInEpsilonPercent(
+	t testingT,
+	failedNow bool,
+	actual, expect any,
+	percent float64,
+	message ...any,
+) error {
+	t.Helper()
+
+	diff := getRelativeDiffPercent(toFloat(actual), toFloat(expect))
+
+	return test(
+		t,
+		func() bool { return diff <= percent },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageInEpsilonPercent,
+			formatValue(actual), formatValue(expect), diff, percent,
+		),
+		message...,
+	)
+}
+
+// isInDelta checks whether the difference between the values is not greater than the delta, and
+// returns the result with the absolute difference. It'll panic if the values and the delta are
+// not the same type, or they are not numbers.
+func isInDelta(x, y, delta any) (bool, any) {
+	xv := reflect.ValueOf(x)
+	yv := reflect.ValueOf(y)
+	dv := reflect.ValueOf(delta)
+
+	if !xv.IsValid() {
+		panic(ErrNotNumber)
+	} else if !yv.IsValid() || !dv.IsValid() ||
+		!isSameType(xv.Type(), yv.Type()) || !isSameType(xv.Type(), dv.Type()) {
+		panic(ErrNotSameType)
+	}
+
+	switch k := xv.Kind(); {
+	case k >= reflect.Int && k <= reflect.Int64:
+		i1, i2, d := xv.Int(), yv.Int(), dv.Int()
+		var diff uint64
+		if i1 >= i2 {
+			diff = uint64(i1) - uint64(i2)
+		} else {
+			diff = uint64(i2) - uint64(i1)
+		}
+
+		var diffValue any = diff
+		if diff <= math.MaxInt64 && !reflect.Zero(xv.Type()).OverflowInt(int64(diff)) {
+			// keeps the type of the values, for example, time.Duration.
+			diffValue = reflect.ValueOf(int64(diff)).Convert(xv.Type()).Interface()
+		}
+
+		return d >= 0 && diff <= uint64(d), diffValue
+	case k >= reflect.Uint && k <= reflect.Uintptr:
+		u1, u2 := xv.Uint(), yv.Uint()
+		diff := u1 - u2
+		if u1 < u2 {
+			diff = u2 - u1
+		}
+
+		return diff <= dv.Uint(), reflect.ValueOf(diff).Convert(xv.Type()).Interface()
+	case k == reflect.Float32 || k == reflect.Float64:
+		diff := math.Abs(xv.Float() - yv.Float())
+
+		return diff <= dv.Float(), diff
+	default:
+		panic(ErrNotNumber)
+	}
+}
+
+// getRelativeDiffPercent returns the relative difference between the actual and expect values
+// in percentage. It returns 0 if both values are 0, and returns +Inf if only the expected value
+// is 0.
+func getRelativeDiffPercent(actual, expect float64) float64 {
+	if actual == expect {
+		return 0
+	} else if expect == 0 {
+		return math.Inf(1)
+	}
+
+	return math.Abs(actual-expect) / math.Abs(expect) * 100
+}
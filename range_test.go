@@ -0,0 +1,180 @@
+package assert
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBetween(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	a.PanicOfNow(func() {
+		mockA.Between(1, uint(0), 2)
+	}, ErrNotSameType)
+	a.PanicOfNow(func() {
+		mockA.Between(true, false, true)
+	}, ErrNotOrderable)
+	a.PanicOfNow(func() {
+		mockA.Between(nil, 1, 2)
+	}, ErrNotOrderable)
+	a.PanicOfNow(func() {
+		mockA.Between(nil, nil, nil)
+	}, ErrNotOrderable)
+	a.PanicOfNow(func() {
+		mockA.Between(1, nil, 2)
+	}, ErrNotSameType)
+	a.PanicOfNow(func() {
+		mockA.BetweenExclusive(1, 0, nil)
+	}, ErrNotSameType)
+
+	testBetween(a, mockA, 42, 0, 100, true, true)
+	testBetween(a, mockA, 0, 0, 100, true, false)
+	testBetween(a, mockA, 100, 0, 100, true, false)
+	testBetween(a, mockA, 42, 0, 40, false, false)
+	testBetween(a, mockA, -1, 0, 40, false, false)
+	testBetween(a, mockA, uint(3), uint(1), uint(5), true, true)
+	testBetween(a, mockA, 1.5, 1.0, 2.0, true, true)
+	testBetween(a, mockA, "b", "a", "c", true, true)
+	testBetween(a, mockA, "d", "a", "c", false, false)
+	testBetween(a, mockA, time.Second, time.Millisecond, time.Minute, true, true)
+
+	now := time.Now()
+	testBetween(a, mockA, now, now.Add(-time.Second), now.Add(time.Second), true, true)
+	testBetween(a, mockA, now, now, now.Add(time.Second), true, false)
+	testBetween(a, mockA, now.Add(time.Minute), now, now.Add(time.Second), false, false)
+
+	err := New(new(testing.T)).Between(42, 0, 40)
+	a.EqualNow(err.Error(), "assert error: 42 not in [0, 40]")
+	err = New(new(testing.T)).BetweenExclusive(40, 0, 40)
+	a.EqualNow(err.Error(), "assert error: 40 not in (0, 40)")
+}
+
+func testBetween(a, mockA *Assertion, v, lo, hi any, isBetween, isBetweenExclusive bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "Between", func() error {
+		return Between(mockA.T, v, lo, hi)
+	}, isBetween)
+	testAssertionFunction(a, "Assertion.Between", func() error {
+		return mockA.Between(v, lo, hi)
+	}, isBetween)
+	testAssertionNowFunction(a, "BetweenNow", func() {
+		BetweenNow(mockA.T, v, lo, hi)
+	}, !isBetween)
+	testAssertionNowFunction(a, "Assertion.BetweenNow", func() {
+		mockA.BetweenNow(v, lo, hi)
+	}, !isBetween)
+
+	testAssertionFunction(a, "BetweenExclusive", func() error {
+		return BetweenExclusive(mockA.T, v, lo, hi)
+	}, isBetweenExclusive)
+	testAssertionFunction(a, "Assertion.BetweenExclusive", func() error {
+		return mockA.BetweenExclusive(v, lo, hi)
+	}, isBetweenExclusive)
+	testAssertionNowFunction(a, "BetweenExclusiveNow", func() {
+		BetweenExclusiveNow(mockA.T, v, lo, hi)
+	}, !isBetweenExclusive)
+	testAssertionNowFunction(a, "Assertion.BetweenExclusiveNow", func() {
+		mockA.BetweenExclusiveNow(v, lo, hi)
+	}, !isBetweenExclusive)
+}
+
+func TestInDelta(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	a.PanicOfNow(func() {
+		mockA.InDelta(1, 2, 0.5)
+	}, ErrNotSameType)
+	a.PanicOfNow(func() {
+		mockA.InDelta("a", "b", "c")
+	}, ErrNotNumber)
+	a.PanicOfNow(func() {
+		mockA.InDelta(nil, 1, 1)
+	}, ErrNotNumber)
+	a.PanicOfNow(func() {
+		mockA.InDelta(nil, nil, nil)
+	}, ErrNotNumber)
+	a.PanicOfNow(func() {
+		mockA.InDelta(1, nil, 1)
+	}, ErrNotSameType)
+	a.PanicOfNow(func() {
+		mockA.InDeltaNow(1, 1, nil)
+	}, ErrNotSameType)
+
+	testInDelta(a, mockA, 42, 40, 2, true)
+	testInDelta(a, mockA, 40, 42, 2, true)
+	testInDelta(a, mockA, 42, 40, 1, false)
+	testInDelta(a, mockA, 42, 40, -3, false)
+	testInDelta(a, mockA, int64(math.MaxInt64), int64(math.MinInt64), int64(1), false)
+	testInDelta(a, mockA, uint(3), uint(5), uint(2), true)
+	testInDelta(a, mockA, uint(5), uint(3), uint(1), false)
+	testInDelta(a, mockA, 1.0, 1.5, 0.5, true)
+	testInDelta(a, mockA, 1.0, 1.5, 0.25, false)
+	testInDelta(a, mockA, time.Second, 900*time.Millisecond, 100*time.Millisecond, true)
+	testInDelta(a, mockA, time.Second, 800*time.Millisecond, 100*time.Millisecond, false)
+
+	err := New(new(testing.T)).InDelta(time.Second, 800*time.Millisecond, 100*time.Millisecond)
+	a.EqualNow(err.Error(), "assert error: the difference between 1s and 800ms is 200ms, more than 100ms")
+}
+
+func testInDelta(a, mockA *Assertion, actual, expect, delta any, isInDelta bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "InDelta", func() error {
+		return InDelta(mockA.T, actual, expect, delta)
+	}, isInDelta)
+	testAssertionFunction(a, "Assertion.InDelta", func() error {
+		return mockA.InDelta(actual, expect, delta)
+	}, isInDelta)
+	testAssertionNowFunction(a, "InDeltaNow", func() {
+		InDeltaNow(mockA.T, actual, expect, delta)
+	}, !isInDelta)
+	testAssertionNowFunction(a, "Assertion.InDeltaNow", func() {
+		mockA.InDeltaNow(actual, expect, delta)
+	}, !isInDelta)
+}
+
+func TestInEpsilonPercent(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	a.PanicOfNow(func() {
+		mockA.InEpsilonPercent(nil, 1, 5)
+	}, ErrNotFloat)
+	a.PanicOfNow(func() {
+		mockA.InEpsilonPercent(1, nil, 5)
+	}, ErrNotFloat)
+
+	testInEpsilonPercent(a, mockA, 105, 100, 5, true)
+	testInEpsilonPercent(a, mockA, 95, 100, 5, true)
+	testInEpsilonPercent(a, mockA, 106, 100, 5, false)
+	testInEpsilonPercent(a, mockA, 0, 0, 0, true)
+	testInEpsilonPercent(a, mockA, 1, 0, 100, false)
+	testInEpsilonPercent(a, mockA, -105, -100, 5, true)
+	testInEpsilonPercent(a, mockA, 1100*time.Millisecond, time.Second, 10, true)
+	testInEpsilonPercent(a, mockA, 1200*time.Millisecond, time.Second, 10, false)
+	testInEpsilonPercent(a, mockA, math.NaN(), 1.0, 10, false)
+
+	err := New(new(testing.T)).InEpsilonPercent(110, 100, 5)
+	a.EqualNow(err.Error(), "assert error: the difference between 110 and 100 is 10%, more than 5%")
+}
+
+func testInEpsilonPercent(a, mockA *Assertion, actual, expect any, percent float64, isInEpsilon bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "InEpsilonPercent", func() error {
+		return InEpsilonPercent(mockA.T, actual, expect, percent)
+	}, isInEpsilon)
+	testAssertionFunction(a, "Assertion.InEpsilonPercent", func() error {
+		return mockA.InEpsilonPercent(actual, expect, percent)
+	}, isInEpsilon)
+	testAssertionNowFunction(a, "InEpsilonPercentNow", func() {
+		InEpsilonPercentNow(mockA.T, actual, expect, percent)
+	}, !isInEpsilon)
+	testAssertionNowFunction(a, "Assertion.InEpsilonPercentNow", func() {
+		mockA.InEpsilonPercentNow(actual, expect, percent)
+	}, !isInEpsilon)
+}
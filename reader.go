@@ -0,0 +1,348 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// readerChunkSize is the size of the chunks to read and compare from the readers.
+	readerChunkSize = 32 * 1024
+	// readerDiffWindowSize is the maximum number of bytes around the first difference to print.
+	readerDiffWindowSize = 32
+)
+
+// readerMismatch is the first difference between two readers.
+type readerMismatch struct {
+	// offset is the byte offset of the first different byte.
+	offset int64
+	// line is the line number of the first different byte, starting from 1.
+	line int
+	// column is the rune column of the first different rune in its line, starting from 1.
+	column int
+	// before is the same bytes before the first difference.
+	before []byte
+	// actual is the bytes from the first difference in the actual reader.
+	actual []byte
+	// expected is the bytes from the first difference in the expected reader.
+	expected []byte
+}
+
+// ReaderEqual tests whether the contents of the readers are the same. It reads and compares the
+// readers in chunks, and stops at the first difference without reading the rest of the readers.
+// It'll set the result to fail with the byte offset, the line and column, and the text or the
+// hexdump around the first difference if the contents are not the same, or any reader returns an
+// error.
+//
+//	a := assert.New(t)
+//	a.ReaderEqual(strings.NewReader("Hello"), strings.NewReader("Hello")) // success
+//	a.ReaderEqual(strings.NewReader("Hello"), strings.NewReader("World")) // fail
+func (a *Assertion) ReaderEqual(actual, expected io.Reader, message ...any) error {
+	a.Helper()
+
+	return tryReaderEqual(a.testingT(), false, actual, expected, message...)
+}
+
+// ReaderEqualNow tests whether the contents of the readers are the same. It'll terminate the
+// execution if the contents are not the same, or any reader returns an error.
+//
+//	a := assert.New(t)
+//	a.ReaderEqualNow(strings.NewReader("Hello"), strings.NewReader("Hello")) // success
+//	a.ReaderEqualNow(strings.NewReader("Hello"), strings.NewReader("World")) // fail and terminate
+//	// never runs
+func (a *Assertion) ReaderEqualNow(actual, expected io.Reader, message ...any) error {
+	a.Helper()
+
+	return tryReaderEqual(a.testingT(), true, actual, expected, message...)
+}
+
+// ReaderEqualBytes tests whether the content of the reader is the expected bytes. It reads and
+// compares the reader in chunks, and stops at the first difference. It'll set the result to fail
+// with the byte offset, the line and column, and the text or the hexdump around the first
+// difference if the content is not the expected bytes, or the reader returns an error.
+//
+//	a := assert.New(t)
+//	a.ReaderEqualBytes(strings.NewReader("Hello"), []byte("Hello")) // success
+//	a.ReaderEqualBytes(strings.NewReader("Hello"), []byte("World")) // fail
+func (a *Assertion) ReaderEqualBytes(r io.Reader, expected []byte, message ...any) error {
+	a.Helper()
+
+	return tryReaderEqual(a.testingT(), false, r, bytes.NewReader(expected), message...)
+}
+
+// ReaderEqualBytesNow tests whether the content of the reader is the expected bytes. It'll
+// terminate the execution if the content is not the expected bytes, or the reader returns an
+// error.
+//
+//	a := assert.New(t)
+//	a.ReaderEqualBytesNow(strings.NewReader("Hello"), []byte("Hello")) // success
+//	a.ReaderEqualBytesNow(strings.NewReader("Hello"), []byte("World")) // fail and terminate
+//	// never runs
+func (a *Assertion) ReaderEqualBytesNow(r io.Reader, expected []byte, message ...any) error {
+	a.Helper()
+
+	return tryReaderEqual(a.testingT(), true, r, bytes.NewReader(expected), message...)
+}
+
+// tryReaderEqual tries to compare the contents of the readers, and it'll fail if the contents are
+// not the same or any reader returns an error.
+func tryReaderEqual(
+	t testingT,
+	failedNow bool,
+	actual, expected io.Reader,
+	message ...any,
+) error {
+	t.Helper()
+
+	mismatch, err := findReaderMismatch(actual, expected)
+
+	defaultMessage := ""
+	if err != nil {
+		defaultMessage = fmt.Sprintf(defaultErrMessageReadReader, err)
+	} else if mismatch != nil {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageReaderEqual,
+			mismatch.offset, mismatch.line, mismatch.column, formatReaderMismatch(mismatch),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return err == nil && mismatch == nil },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// findReaderMismatch reads and compares the readers in chunks, and returns the first difference
+// between them. It returns nil if the contents of the readers are the same.
+func findReaderMismatch(actual, expected io.Reader) (*readerMismatch, error) {
+	actualBuf := make([]byte, readerChunkSize)
+	expectedBuf := make([]byte, readerChunkSize)
+
+	mismatch := &readerMismatch{line: 1, column: 1}
+	tail := make([]byte, 0, readerDiffWindowSize)
+
+	for {
+		actualN, actualEOF, err := readChunk(actual, actualBuf)
+		if err != nil {
+			return nil, err
+		}
+		expectedN, expectedEOF, err := readChunk(expected, expectedBuf)
+		if err != nil {
+			return nil, err
+		}
+
+		n := minInt(actualN, expectedN)
+		i := 0
+		for i < n && actualBuf[i] == expectedBuf[i] {
+			i++
+		}
+
+		mismatch.offset += int64(i)
+		for _, b := range actualBuf[:i] {
+			if b == '\n' {
+				mismatch.line++
+				mismatch.column = 1
+			} else if utf8.RuneStart(b) {
+				mismatch.column++
+			}
+		}
+		tail = appendWindowTail(tail, actualBuf[:i])
+
+		if i < actualN || i < expectedN {
+			if getPartialRuneLength(tail) > 0 {
+				// the first difference is in the middle of a rune that starts before it.
+				mismatch.column--
+			}
+			mismatch.before = tail
+			mismatch.actual, err = readWindow(actual, actualBuf[i:actualN], actualEOF)
+			if err != nil {
+				return nil, err
+			}
+			mismatch.expected, err = readWindow(expected, expectedBuf[i:expectedN], expectedEOF)
+			if err != nil {
+				return nil, err
+			}
+			return mismatch, nil
+		}
+
+		if actualEOF && expectedEOF {
+			return nil, nil
+		}
+	}
+}
+
+// readChunk reads until the buffer is full or the reader reaches EOF, and returns the number of
+// bytes read, and whether the reader reaches EOF.
+func readChunk(r io.Reader, buf []byte) (int, bool, error) {
+	n, err := io.ReadFull(r, buf)
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return n, true, nil
+	}
+
+	return n, false, err
+}
+
+// readWindow returns up to the window size of bytes from the remaining bytes of the chunk and the
+// reader.
+func readWindow(r io.Reader, remaining []byte, isEOF bool) ([]byte, error) {
+	window := make([]byte, readerDiffWindowSize)
+	n := copy(window, remaining)
+
+	if n < readerDiffWindowSize && !isEOF {
+		m, _, err := readChunk(r, window[n:])
+		if err != nil {
+			return nil, err
+		}
+		n += m
+	}
+
+	return window[:n], nil
+}
+
+// appendWindowTail appends the data to the tail, and keeps up to the window size of the last
+// bytes.
+func appendWindowTail(tail, data []byte) []byte {
+	if len(data) >= readerDiffWindowSize {
+		return append(tail[:0], data[len(data)-readerDiffWindowSize:]...)
+	}
+
+	tail = append(tail, data...)
+	if len(tail) > readerDiffWindowSize {
+		tail = append(tail[:0], tail[len(tail)-readerDiffWindowSize:]...)
+	}
+
+	return tail
+}
+
+// formatReaderMismatch formats the bytes around the first difference. It prints the quoted texts
+// with a caret under the first different byte for the texts, and the hexdumps for the binary
+// contents.
+func formatReaderMismatch(mismatch *readerMismatch) string {
+	// skips the incomplete rune at the beginning of the window, and moves the incomplete rune at the
+	// end of the same bytes to the different parts, so the caret points to the different rune.
+	before := mismatch.before
+	for len(before) > 0 && !utf8.RuneStart(before[0]) {
+		before = before[1:]
+	}
+	partial := before[len(before)-getPartialRuneLength(before):]
+	before = before[:len(before)-len(partial)]
+
+	expected := append(append([]byte{}, before...), partial...)
+	expected = append(expected, mismatch.expected...)
+	actual := append(append([]byte{}, before...), partial...)
+	actual = append(actual, mismatch.actual...)
+
+	if isBinaryContent(expected) || isBinaryContent(actual) {
+		offset := mismatch.offset - int64(len(before)+len(partial))
+		return fmt.Sprintf(
+			"expected:\n%s\nactual:\n%s",
+			formatHexdump(expected, offset), formatHexdump(actual, offset),
+		)
+	}
+
+	caret := len("expected: ") + getDisplayWidth(strconv.Quote(string(before))) - 1
+
+	return fmt.Sprintf(
+		"expected: %s%s\nactual:   %s%s\n%s^",
+		strconv.Quote(string(expected)), getEOFMark(mismatch.expected),
+		strconv.Quote(string(actual)), getEOFMark(mismatch.actual),
+		strings.Repeat(" ", caret),
+	)
+}
+
+// getPartialRuneLength returns the number of the bytes of the incomplete rune at the end of the
+// data, and it returns 0 if the data ends with a complete rune or an invalid byte.
+func getPartialRuneLength(data []byte) int {
+	i := len(data) - 1
+	for i > 0 && len(data)-i < utf8.UTFMax && !utf8.RuneStart(data[i]) {
+		i--
+	}
+	if i < 0 || utf8.FullRune(data[i:]) {
+		return 0
+	}
+
+	return len(data) - i
+}
+
+// getDisplayWidth returns the number of the columns to display the string in a terminal. The
+// combining marks take no column, and the East Asian wide and fullwidth characters take two
+// columns.
+func getDisplayWidth(s string) int {
+	width := 0
+
+	for _, r := range s {
+		switch {
+		case unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		case isWideRune(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+
+	return width
+}
+
+// isWideRune checks whether the rune is an East Asian wide or fullwidth character.
+func isWideRune(r rune) bool {
+	return unicode.Is(wideRunes, r)
+}
+
+// getEOFMark returns the mark of the end of the content if no bytes after the first difference.
+func getEOFMark(data []byte) string {
+	if len(data) == 0 {
+		return " (EOF)"
+	}
+	return ""
+}
+
+// formatHexdump formats the data as a hexdump like `hexdump -C`, and the offsets start from the
+// base offset.
+func formatHexdump(data []byte, offset int64) string {
+	builder := strings.Builder{}
+
+	for i := 0; i < len(data); i += 16 {
+		row := data[i:minInt(i+16, len(data))]
+
+		if i > 0 {
+			builder.WriteByte('\n')
+		}
+		builder.WriteString(fmt.Sprintf("%08x  ", offset+int64(i)))
+
+		for j := 0; j < 16; j++ {
+			if j == 8 {
+				builder.WriteByte(' ')
+			}
+			if j < len(row) {
+				builder.WriteString(fmt.Sprintf("%02x ", row[j]))
+			} else {
+				builder.WriteString("   ")
+			}
+		}
+
+		builder.WriteString(" |")
+		for _, b := range row {
+			if b >= 0x20 && b < 0x7f {
+				builder.WriteByte(b)
+			} else {
+				builder.WriteByte('.')
+			}
+		}
+		builder.WriteByte('|')
+	}
+
+	if len(data) == 0 {
+		builder.WriteString(fmt.Sprintf("%08x  (EOF)", offset))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,180 @@
+package assert
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestReaderEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	large := strings.Repeat("0123456789abcdef\n", readerChunkSize/8)
+
+	testReaderEqual(a, mockA, "", "", true)
+	testReaderEqual(a, mockA, "Hello world", "Hello world", true)
+	testReaderEqual(a, mockA, large, large, true)
+	testReaderEqual(a, mockA, "Hello world", "Hello there", false)
+	testReaderEqual(a, mockA, "Hello", "Hello world", false)
+	testReaderEqual(a, mockA, "Hello world", "Hello", false)
+	testReaderEqual(a, mockA, large+"x", large+"y", false)
+	testReaderEqual(a, mockA, large, large[:len(large)-1], false)
+
+	err := mockA.ReaderEqual(strings.NewReader("Hello"), iotest.ErrReader(errors.New("test")))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect readers readable, got error: test")
+}
+
+func testReaderEqual(a, mockA *Assertion, actual, expected string, isOk bool) {
+	a.Helper()
+
+	// the one byte readers make sure the readers are compared across the reads
+	testAssertionFunction(a, "ReaderEqual", func() error {
+		return ReaderEqual(
+			mockA.T,
+			iotest.OneByteReader(strings.NewReader(actual)),
+			strings.NewReader(expected),
+		)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ReaderEqual", func() error {
+		return mockA.ReaderEqual(
+			strings.NewReader(actual),
+			iotest.HalfReader(strings.NewReader(expected)),
+		)
+	}, isOk)
+	testAssertionNowFunction(a, "ReaderEqualNow", func() {
+		ReaderEqualNow(mockA.T, strings.NewReader(actual), strings.NewReader(expected))
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ReaderEqualNow", func() {
+		mockA.ReaderEqualNow(strings.NewReader(actual), strings.NewReader(expected))
+	}, !isOk)
+
+	testAssertionFunction(a, "ReaderEqualBytes", func() error {
+		return ReaderEqualBytes(mockA.T, strings.NewReader(actual), []byte(expected))
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ReaderEqualBytes", func() error {
+		return mockA.ReaderEqualBytes(strings.NewReader(actual), []byte(expected))
+	}, isOk)
+	testAssertionNowFunction(a, "ReaderEqualBytesNow", func() {
+		ReaderEqualBytesNow(mockA.T, strings.NewReader(actual), []byte(expected))
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ReaderEqualBytesNow", func() {
+		mockA.ReaderEqualBytesNow(strings.NewReader(actual), []byte(expected))
+	}, !isOk)
+}
+
+func TestReaderEqualMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.ReaderEqual(
+		strings.NewReader("line 1\nline 2\nHello world"),
+		strings.NewReader("line 1\nline 2\nHello there"),
+	)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 20 (line 3, column 7):
+
+expected: "line 1\nline 2\nHello there"
+actual:   "line 1\nline 2\nHello world"
+                                 ^`)
+
+	err = mockA.ReaderEqualBytes(strings.NewReader("Hello"), []byte("Hello world"))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 5 (line 1, column 6):
+
+expected: "Hello world"
+actual:   "Hello" (EOF)
+                ^`)
+
+	err = mockA.ReaderEqual(strings.NewReader("héllo wörld"), strings.NewReader("héllo wörd"))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 11 (line 1, column 10):
+
+expected: "héllo wörd"
+actual:   "héllo wörld"
+                    ^`)
+
+	err = mockA.ReaderEqual(strings.NewReader("你好，世界"), strings.NewReader("你好，朋友"))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 9 (line 1, column 4):
+
+expected: "你好，朋友"
+actual:   "你好，世界"
+                 ^`)
+
+	// differs in the middle of a multibyte rune
+	err = mockA.ReaderEqual(strings.NewReader("aé"), strings.NewReader("aè"))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 2 (line 1, column 2):
+
+expected: "aè"
+actual:   "aé"
+            ^`)
+
+	err = mockA.ReaderEqualBytes(bytes.NewReader([]byte{0, 1, 2, 3}), []byte{0, 1, 0xff})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect readers equal, first difference at offset 2 (line 1, column 3):
+
+expected:
+00000000  00 01 ff                                          |...|
+actual:
+00000000  00 01 02 03                                       |....|`)
+}
+
+func TestFindReaderMismatch(t *testing.T) {
+	a := New(t)
+
+	prefix := strings.Repeat("a", readerChunkSize-10) + "\n" + strings.Repeat("b", 20)
+	mismatch, err := findReaderMismatch(
+		strings.NewReader(prefix+"x"+strings.Repeat("c", 100)),
+		strings.NewReader(prefix+"y"),
+	)
+	a.NilNow(err)
+	a.NotNilNow(mismatch)
+	a.EqualNow(mismatch.offset, int64(len(prefix)))
+	a.EqualNow(mismatch.line, 2)
+	a.EqualNow(mismatch.column, 21)
+	a.EqualNow(string(mismatch.before), prefix[len(prefix)-readerDiffWindowSize:])
+	a.EqualNow(string(mismatch.actual), "x"+strings.Repeat("c", readerDiffWindowSize-1))
+	a.EqualNow(string(mismatch.expected), "y")
+
+	// stops at the first difference
+	r := strings.NewReader("x" + strings.Repeat("a", readerChunkSize*4))
+	mismatch, err = findReaderMismatch(r, strings.NewReader("y"))
+	a.NilNow(err)
+	a.NotNilNow(mismatch)
+	a.EqualNow(mismatch.offset, int64(0))
+	a.GtNow(r.Len(), readerChunkSize*2)
+
+	mismatch, err = findReaderMismatch(io.LimitReader(r, 0), strings.NewReader(""))
+	a.NilNow(err)
+	a.NilNow(mismatch)
+}
+
+func TestGetDisplayWidth(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(getDisplayWidth("hello"), 5)
+	a.EqualNow(getDisplayWidth("e\u0301"), 1)
+	a.EqualNow(getDisplayWidth("你好"), 4)
+	a.EqualNow(getDisplayWidth("\u3000\u303f"), 3)
+	a.EqualNow(getDisplayWidth("\uff21\uff61"), 3)
+	a.EqualNow(getDisplayWidth("\ua960\ud7b0"), 3)
+	a.EqualNow(getDisplayWidth("\U00020000\U00030000"), 4)
+	a.EqualNow(getDisplayWidth("\u231a\U0001f680\U0001fa70"), 6)
+}
+
+func TestFormatHexdump(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(formatHexdump(nil, 16), "00000010  (EOF)")
+	a.EqualNow(
+		formatHexdump([]byte("0123456789abcdef\x00\xff"), 0),
+		"00000000  30 31 32 33 34 35 36 37  38 39 61 62 63 64 65 66  |0123456789abcdef|\n"+
+			"00000010  00 ff                                             |..|",
+	)
+}
@@ -0,0 +1,483 @@
+package assert
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// HTTPServer is a recording HTTP test server built on `httptest.Server`. It responds the requests
+// with the configured canned responses, records all the received requests, and provides the
+// assertions of the received requests. The server will be closed after the test completed.
+type HTTPServer struct {
+	*httptest.Server
+
+	// t is the test that the server belongs to.
+	t *testing.T
+	// mu is the lock of the routes and the recorded requests.
+	mu sync.Mutex
+	// routes are the configured routes of the server.
+	routes []*httpRoute
+	// requests are the recorded requests that received by the server.
+	requests []*RecordedRequest
+}
+
+// HTTPResponse is the canned response of the recording HTTP test server.
+type HTTPResponse struct {
+	// StatusCode is the status code of the response, and it'll be 200 if it is not set.
+	StatusCode int
+	// Header is the headers of the response.
+	Header http.Header
+	// Body is the body of the response.
+	Body string
+}
+
+// RecordedRequest is the request that received by the recording HTTP test server.
+type RecordedRequest struct {
+	// Method is the method of the request.
+	Method string
+	// URL is the url of the request.
+	URL *url.URL
+	// Header is the headers of the request.
+	Header http.Header
+	// Body is the body of the request.
+	Body []byte
+	// isExpected indicates whether the request matches any configured route.
+	isExpected bool
+}
+
+// httpRoute is a configured route of the recording HTTP test server.
+type httpRoute struct {
+	// method is the method of the route, and an empty method matches any method.
+	method string
+	// path is the path of the route.
+	path string
+	// handler is the handler to respond the requests of the route.
+	handler http.Handler
+}
+
+// NewHTTPServer starts and returns a recording HTTP test server, and the server will be closed
+// after the test completed. The requests that do not match any configured route will be responded
+// with 404 Not Found.
+//
+//	a := assert.New(t)
+//	server := a.NewHTTPServer()
+//	server.Handle(http.MethodGet, "/users/1", assert.HTTPResponse{Body: `{"id":1}`})
+//	client := NewClient(server.URL)
+//	// ...
+//	server.ReceivedRequest(http.MethodGet, "/users/1") // success
+func (a *Assertion) NewHTTPServer() *HTTPServer {
+	return newHTTPServer(a.T)
+}
+
+// Handle configures the server to respond the requests of the method and the path with the canned
+// response, and returns the server itself. An empty method matches any method. The later
+// configured routes take precedence over the earlier ones.
+//
+//	a := assert.New(t)
+//	server := a.NewHTTPServer().
+//	  Handle(http.MethodGet, "/users/1", assert.HTTPResponse{Body: `{"id":1}`}).
+//	  Handle(http.MethodDelete, "/users/1", assert.HTTPResponse{StatusCode: http.StatusNoContent})
+func (s *HTTPServer) Handle(method, path string, resp HTTPResponse) *HTTPServer {
+	return s.HandleFunc(method, path, func(w http.ResponseWriter, r *http.Request) {
+		for key, values := range resp.Header {
+			for _, value := range values {
+				w.Header().Add(key, value)
+			}
+		}
+
+		if resp.StatusCode != 0 {
+			w.WriteHeader(resp.StatusCode)
+		}
+
+		io.WriteString(w, resp.Body)
+	})
+}
+
+// HandleFunc configures the server to respond the requests of the method and the path by the
+// handler function, and returns the server itself. An empty method matches any method. The later
+// configured routes take precedence over the earlier ones.
+//
+//	a := assert.New(t)
+//	server := a.NewHTTPServer().
+//	  HandleFunc(http.MethodPost, "/echo", func(w http.ResponseWriter, r *http.Request) {
+//	    io.Copy(w, r.Body)
+//	  })
+func (s *HTTPServer) HandleFunc(method, path string, fn http.HandlerFunc) *HTTPServer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.routes = append(s.routes, &httpRoute{
+		method:  method,
+		path:    path,
+		handler: fn,
+	})
+
+	return s
+}
+
+// Requests returns all the requests that received by the server in order.
+func (s *HTTPServer) Requests() []*RecordedRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := make([]*RecordedRequest, len(s.requests))
+	copy(requests, s.requests)
+
+	return requests
+}
+
+// ReceivedRequest tests whether the server has received any request of the method and the path.
+// It'll set the result to fail with the received requests if no such request received.
+//
+//	server.ReceivedRequest(http.MethodGet, "/users/1") // success
+//	server.ReceivedRequest(http.MethodGet, "/users/2") // fail
+func (s *HTTPServer) ReceivedRequest(method, path string, message ...any) error {
+	s.t.Helper()
+
+	return s.tryReceivedRequest(false, method, path, message...)
+}
+
+// ReceivedRequestNow tests whether the server has received any request of the method and the
+// path. It'll terminate the execution if no such request received.
+//
+//	server.ReceivedRequestNow(http.MethodGet, "/users/1") // success
+//	server.ReceivedRequestNow(http.MethodGet, "/users/2") // fail and terminate
+//	// never runs
+func (s *HTTPServer) ReceivedRequestNow(method, path string, message ...any) error {
+	s.t.Helper()
+
+	return s.tryReceivedRequest(true, method, path, message...)
+}
+
+// RequestCount tests whether the number of the received requests of the method and the path is
+// the expected number. It'll set the result to fail if the number is not the expected number.
+//
+//	server.RequestCount(http.MethodGet, "/users/1", 1) // success
+//	server.RequestCount(http.MethodGet, "/users/1", 2) // fail
+func (s *HTTPServer) RequestCount(method, path string, n int, message ...any) error {
+	s.t.Helper()
+
+	return s.tryRequestCount(false, method, path, n, message...)
+}
+
+// RequestCountNow tests whether the number of the received requests of the method and the path is
+// the expected number. It'll terminate the execution if the number is not the expected number.
+//
+//	server.RequestCountNow(http.MethodGet, "/users/1", 1) // success
+//	server.RequestCountNow(http.MethodGet, "/users/1", 2) // fail and terminate
+//	// never runs
+func (s *HTTPServer) RequestCountNow(method, path string, n int, message ...any) error {
+	s.t.Helper()
+
+	return s.tryRequestCount(true, method, path, n, message...)
+}
+
+// RequestHeader tests whether the server has received any request of the method and the path with
+// the header of the expected value. It'll set the result to fail if no such request received.
+//
+//	server.RequestHeader(http.MethodGet, "/users/1", "Accept", "application/json") // success
+//	server.RequestHeader(http.MethodGet, "/users/1", "Accept", "text/html") // fail
+func (s *HTTPServer) RequestHeader(method, path, key, value string, message ...any) error {
+	s.t.Helper()
+
+	return s.tryRequestHeader(false, method, path, key, value, message...)
+}
+
+// RequestHeaderNow tests whether the server has received any request of the method and the path
+// with the header of the expected value. It'll terminate the execution if no such request
+// received.
+//
+//	server.RequestHeaderNow(http.MethodGet, "/users/1", "Accept", "application/json") // success
+//	server.RequestHeaderNow(http.MethodGet, "/users/1", "Accept", "text/html") // fail and terminate
+//	// never runs
+func (s *HTTPServer) RequestHeaderNow(method, path, key, value string, message ...any) error {
+	s.t.Helper()
+
+	return s.tryRequestHeader(true, method, path, key, value, message...)
+}
+
+// RequestBodyJSONEqual tests whether the server has received any request of the method and the
+// path with the JSON body that equals to the expected value. The expected value can be a JSON
+// string, a JSON byte slice, or any value that can be encoded to JSON. It'll set the result to
+// fail if no such request received.
+//
+//	server.RequestBodyJSONEqual(http.MethodPost, "/users", `{"name":"test"}`) // success
+//	server.RequestBodyJSONEqual(http.MethodPost, "/users", `{"name":"other"}`) // fail
+func (s *HTTPServer) RequestBodyJSONEqual(
+	method, path string,
+	expected any,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	return s.tryRequestBodyJSONEqual(false, method, path, expected, message...)
+}
+
+// RequestBodyJSONEqualNow tests whether the server has received any request of the method and the
+// path with the JSON body that equals to the expected value. It'll terminate the execution if no
+// such request received.
+//
+//	server.RequestBodyJSONEqualNow(http.MethodPost, "/users", `{"name":"test"}`) // success
+//	server.RequestBodyJSONEqualNow(http.MethodPost, "/users", `{"name":"other"}`) // fail and terminate
+//	// never runs
+func (s *HTTPServer) RequestBodyJSONEqualNow(
+	method, path string,
+	expected any,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	return s.tryRequestBodyJSONEqual(true, method, path, expected, message...)
+}
+
+// NoUnexpectedRequests registers a cleanup function to the test to check whether the server has
+// received any request that does not match the configured routes when the test and all its
+// subtests completed. It'll set the result to fail with the unexpected requests if any.
+//
+//	a := assert.New(t)
+//	server := a.NewHTTPServer().Handle(http.MethodGet, "/users/1", assert.HTTPResponse{})
+//	server.NoUnexpectedRequests()
+//	http.Get(server.URL + "/users/2") // the test will fail after it completed
+func (s *HTTPServer) NoUnexpectedRequests() {
+	s.t.Helper()
+
+	s.t.Cleanup(func() {
+		s.t.Helper()
+
+		// close the server to wait for the outstanding requests
+		s.Close()
+
+		s.tryNoUnexpectedRequests(false)
+	})
+}
+
+// tryReceivedRequest tries to find the request of the method and the path, and it'll fail if no
+// such request received.
+func (s *HTTPServer) tryReceivedRequest(
+	failedNow bool,
+	method, path string,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	requests := s.Requests()
+
+	return test(
+		s.t,
+		func() bool { return len(filterRecordedRequests(requests, method, path)) > 0 },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageReceivedRequest,
+			method, path, formatRecordedRequests(requests),
+		),
+		message...,
+	)
+}
+
+// tryRequestCount tries to count the requests of the method and the path, and it'll fail if the
+// number is not the expected number.
+func (s *HTTPServer) tryRequestCount(
+	failedNow bool,
+	method, path string,
+	n int,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	count := len(filterRecordedRequests(s.Requests(), method, path))
+
+	return test(
+		s.t,
+		func() bool { return count == n },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageRequestCount, n, method, path, count),
+		message...,
+	)
+}
+
+// tryRequestHeader tries to find the request of the method and the path with the header of the
+// expected value, and it'll fail if no such request received.
+func (s *HTTPServer) tryRequestHeader(
+	failedNow bool,
+	method, path, key, value string,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	requests := filterRecordedRequests(s.Requests(), method, path)
+	values := make([][]string, 0, len(requests))
+	for _, req := range requests {
+		values = append(values, req.Header.Values(key))
+	}
+
+	return test(
+		s.t,
+		func() bool {
+			for _, vals := range values {
+				for _, v := range vals {
+					if v == value {
+						return true
+					}
+				}
+			}
+			return false
+		},
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageRequestHeader,
+			method, path, http.CanonicalHeaderKey(key), value, values,
+		),
+		message...,
+	)
+}
+
+// tryRequestBodyJSONEqual tries to find the request of the method and the path with the JSON body
+// that equals to the expected value, and it'll fail if no such request received.
+func (s *HTTPServer) tryRequestBodyJSONEqual(
+	failedNow bool,
+	method, path string,
+	expected any,
+	message ...any,
+) error {
+	s.t.Helper()
+
+	requests := filterRecordedRequests(s.Requests(), method, path)
+	expectedJSON := toJSON(expected)
+
+	bodies := make([]string, 0, len(requests))
+	for _, req := range requests {
+		bodies = append(bodies, string(req.Body))
+	}
+
+	return test(
+		s.t,
+		func() bool {
+			for _, req := range requests {
+				if isJSONEqual(req.Body, expectedJSON) {
+					return true
+				}
+			}
+			return false
+		},
+		failedNow,
+		fmt.Sprintf(defaultErrMessageRequestBodyJSON, method, path, expectedJSON, bodies),
+		message...,
+	)
+}
+
+// tryNoUnexpectedRequests tries to find the requests that do not match the configured routes, and
+// it'll fail if any.
+func (s *HTTPServer) tryNoUnexpectedRequests(failedNow bool, message ...any) error {
+	s.t.Helper()
+
+	unexpected := make([]*RecordedRequest, 0)
+	for _, req := range s.Requests() {
+		if !req.isExpected {
+			unexpected = append(unexpected, req)
+		}
+	}
+
+	return test(
+		s.t,
+		func() bool { return len(unexpected) == 0 },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageUnexpectedRequests,
+			len(unexpected), formatRecordedRequests(unexpected),
+		),
+		message...,
+	)
+}
+
+// ServeHTTP records the request, and responds it by the matched route. It'll respond 404 Not Found
+// if no route matches the request.
+func (s *HTTPServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	req := &RecordedRequest{
+		Method: r.Method,
+		URL:    r.URL,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+
+	s.mu.Lock()
+	var handler http.Handler
+	for i := len(s.routes) - 1; i >= 0; i-- {
+		route := s.routes[i]
+		if route.match(r.Method, r.URL.Path) {
+			handler = route.handler
+			break
+		}
+	}
+	req.isExpected = handler != nil
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+
+	if handler == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// match checks whether the route matches the method and the path.
+func (route *httpRoute) match(method, path string) bool {
+	return (route.method == "" || route.method == method) && route.path == path
+}
+
+// newHTTPServer starts a recording HTTP test server, and registers a cleanup function to close the
+// server after the test completed.
+func newHTTPServer(t *testing.T) *HTTPServer {
+	s := &HTTPServer{
+		t:        t,
+		routes:   make([]*httpRoute, 0),
+		requests: make([]*RecordedRequest, 0),
+	}
+	s.Server = httptest.NewServer(s)
+
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// filterRecordedRequests returns the requests of the method and the path, and an empty method
+// matches any method.
+func filterRecordedRequests(
+	requests []*RecordedRequest,
+	method, path string,
+) []*RecordedRequest {
+	filtered := make([]*RecordedRequest, 0, len(requests))
+
+	for _, req := range requests {
+		if (method == "" || req.Method == method) && req.URL.Path == path {
+			filtered = append(filtered, req)
+		}
+	}
+
+	return filtered
+}
+
+// formatRecordedRequests formats the method and the uri of the requests line by line.
+func formatRecordedRequests(requests []*RecordedRequest) string {
+	if len(requests) == 0 {
+		return "(none)"
+	}
+
+	lines := make([]string, 0, len(requests))
+	for _, req := range requests {
+		lines = append(lines, fmt.Sprintf("%s %s", req.Method, req.URL.RequestURI()))
+	}
+
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,251 @@
+package assert
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func newTestHTTPServer(t *testing.T) *HTTPServer {
+	return newHTTPServer(t).
+		Handle(http.MethodGet, "/users/1", HTTPResponse{
+			Header: http.Header{"Content-Type": {"application/json"}},
+			Body:   `{"id":1}`,
+		}).
+		Handle(http.MethodPost, "/users", HTTPResponse{StatusCode: http.StatusCreated}).
+		HandleFunc("", "/echo", func(w http.ResponseWriter, r *http.Request) {
+			io.Copy(w, r.Body)
+		})
+}
+
+func sendTestHTTPRequest(a *Assertion, method, url, body string, header http.Header) string {
+	a.Helper()
+
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	a.NilNow(err)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	a.NilNow(err)
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	a.NilNow(err)
+
+	return resp.Status + " " + string(data)
+}
+
+func TestHTTPServer(t *testing.T) {
+	a := New(t)
+	server := a.NewHTTPServer().
+		Handle(http.MethodGet, "/", HTTPResponse{Body: "first"}).
+		Handle(http.MethodGet, "/", HTTPResponse{Body: "second"})
+
+	a.EqualNow(sendTestHTTPRequest(a, http.MethodGet, server.URL, "", nil), "200 OK second")
+	a.EqualNow(
+		sendTestHTTPRequest(a, http.MethodGet, server.URL+"/unknown", "", nil),
+		"404 Not Found 404 page not found\n",
+	)
+
+	server = newTestHTTPServer(t)
+	a.EqualNow(
+		sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", nil),
+		`200 OK {"id":1}`,
+	)
+	a.EqualNow(
+		sendTestHTTPRequest(a, http.MethodPost, server.URL+"/users", `{"name":"test"}`, nil),
+		"201 Created ",
+	)
+	a.EqualNow(
+		sendTestHTTPRequest(a, http.MethodPut, server.URL+"/echo?q=1", "hello", nil),
+		"200 OK hello",
+	)
+
+	requests := server.Requests()
+	a.EqualNow(len(requests), 3)
+	a.EqualNow(requests[1].Method, http.MethodPost)
+	a.EqualNow(string(requests[1].Body), `{"name":"test"}`)
+	a.EqualNow(requests[2].URL.RequestURI(), "/echo?q=1")
+	a.TrueNow(requests[2].isExpected)
+}
+
+func TestHTTPServerCleanup(t *testing.T) {
+	a := New(t)
+
+	var server *HTTPServer
+	t.Run("NewHTTPServer", func(t *testing.T) {
+		server = NewHTTPServer(t).Handle(http.MethodGet, "/users/1", HTTPResponse{})
+		server.NoUnexpectedRequests()
+		sendTestHTTPRequest(New(t), http.MethodGet, server.URL+"/users/1", "", nil)
+	})
+
+	_, err := http.Get(server.URL)
+	a.NotNilNow(err)
+}
+
+func TestHTTPServerReceivedRequest(t *testing.T) {
+	a := New(t)
+	server := newTestHTTPServer(new(testing.T))
+	defer server.Close()
+
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", nil)
+
+	testHTTPServerReceivedRequest(a, server, http.MethodGet, "/users/1", true)
+	testHTTPServerReceivedRequest(a, server, "", "/users/1", true)
+	testHTTPServerReceivedRequest(a, server, http.MethodPost, "/users/1", false)
+	testHTTPServerReceivedRequest(a, server, http.MethodGet, "/users/2", false)
+
+	err := server.ReceivedRequest(http.MethodGet, "/users/2")
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect request GET /users/2, received requests:\nGET /users/1",
+	)
+}
+
+func testHTTPServerReceivedRequest(
+	a *Assertion,
+	server *HTTPServer,
+	method, path string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPServer.ReceivedRequest", func() error {
+		return server.ReceivedRequest(method, path)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPServer.ReceivedRequestNow", func() {
+		server.ReceivedRequestNow(method, path)
+	}, !isOk)
+}
+
+func TestHTTPServerRequestCount(t *testing.T) {
+	a := New(t)
+	server := newTestHTTPServer(new(testing.T))
+	defer server.Close()
+
+	testHTTPServerRequestCount(a, server, http.MethodGet, "/users/1", 0, true)
+	testHTTPServerRequestCount(a, server, http.MethodGet, "/users/1", 1, false)
+
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", nil)
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1?q=1", "", nil)
+	sendTestHTTPRequest(a, http.MethodPost, server.URL+"/echo", "", nil)
+
+	testHTTPServerRequestCount(a, server, http.MethodGet, "/users/1", 2, true)
+	testHTTPServerRequestCount(a, server, http.MethodGet, "/users/1", 1, false)
+	testHTTPServerRequestCount(a, server, http.MethodGet, "/echo", 0, true)
+	testHTTPServerRequestCount(a, server, "", "/echo", 1, true)
+}
+
+func testHTTPServerRequestCount(
+	a *Assertion,
+	server *HTTPServer,
+	method, path string,
+	n int,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPServer.RequestCount", func() error {
+		return server.RequestCount(method, path, n)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPServer.RequestCountNow", func() {
+		server.RequestCountNow(method, path, n)
+	}, !isOk)
+}
+
+func TestHTTPServerRequestHeader(t *testing.T) {
+	a := New(t)
+	server := newTestHTTPServer(new(testing.T))
+	defer server.Close()
+
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", http.Header{
+		"Accept": {"application/json"},
+	})
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", http.Header{
+		"Accept": {"text/plain"},
+	})
+
+	testHTTPServerRequestHeader(a, server, "/users/1", "Accept", "application/json", true)
+	testHTTPServerRequestHeader(a, server, "/users/1", "accept", "text/plain", true)
+	testHTTPServerRequestHeader(a, server, "/users/1", "Accept", "text/html", false)
+	testHTTPServerRequestHeader(a, server, "/users/2", "Accept", "application/json", false)
+
+	err := server.RequestHeader(http.MethodGet, "/users/1", "accept", "text/html")
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(
+		err.Error(),
+		`with header Accept: text/html, got [["application/json"] ["text/plain"]]`,
+	))
+}
+
+func testHTTPServerRequestHeader(
+	a *Assertion,
+	server *HTTPServer,
+	path, key, value string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPServer.RequestHeader", func() error {
+		return server.RequestHeader(http.MethodGet, path, key, value)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPServer.RequestHeaderNow", func() {
+		server.RequestHeaderNow(http.MethodGet, path, key, value)
+	}, !isOk)
+}
+
+func TestHTTPServerRequestBodyJSONEqual(t *testing.T) {
+	a := New(t)
+	server := newTestHTTPServer(new(testing.T))
+	defer server.Close()
+
+	sendTestHTTPRequest(a, http.MethodPost, server.URL+"/users", `{"name": "a", "age": 1}`, nil)
+	sendTestHTTPRequest(a, http.MethodPost, server.URL+"/users", `{"name": "b", "age": 2}`, nil)
+
+	testHTTPServerRequestBodyJSONEqual(a, server, `{"age":1,"name":"a"}`, true)
+	testHTTPServerRequestBodyJSONEqual(a, server, map[string]any{"name": "b", "age": 2}, true)
+	testHTTPServerRequestBodyJSONEqual(a, server, `{"name":"c","age":3}`, false)
+	testHTTPServerRequestBodyJSONEqual(a, server, `{"name":"a"}`, false)
+}
+
+func testHTTPServerRequestBodyJSONEqual(
+	a *Assertion,
+	server *HTTPServer,
+	expected any,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "HTTPServer.RequestBodyJSONEqual", func() error {
+		return server.RequestBodyJSONEqual(http.MethodPost, "/users", expected)
+	}, isOk)
+	testAssertionNowFunction(a, "HTTPServer.RequestBodyJSONEqualNow", func() {
+		server.RequestBodyJSONEqualNow(http.MethodPost, "/users", expected)
+	}, !isOk)
+}
+
+func TestHTTPServerNoUnexpectedRequests(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+	server := newTestHTTPServer(mockT)
+	defer server.Close()
+
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/1", "", nil)
+	a.NilNow(server.tryNoUnexpectedRequests(false))
+	a.NotTrueNow(mockT.Failed())
+
+	sendTestHTTPRequest(a, http.MethodGet, server.URL+"/users/2?q=1", "", nil)
+	sendTestHTTPRequest(a, http.MethodDelete, server.URL+"/users/1", "", nil)
+
+	err := server.tryNoUnexpectedRequests(false)
+	a.NotNilNow(err)
+	a.TrueNow(mockT.Failed())
+	a.EqualNow(
+		err.Error(),
+		"assert error: got 2 unexpected request(s):\nGET /users/2?q=1\nDELETE /users/1",
+	)
+}
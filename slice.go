@@ -3,7 +3,6 @@ package assert
 import (
 	"fmt"
 	"reflect"
-	"testing"
 )
 
 // NotContainsElement tests whether the array or slice contains the specified element or not, and
@@ -17,7 +16,7 @@ import (
 func (a *Assertion) ContainsElement(source, expect any, message ...any) error {
 	a.Helper()
 
-	return tryContainsElement(a.T, false, source, expect, message...)
+	return tryContainsElement(a.testingT(), false, source, expect, message...)
 }
 
 // ContainsElementNow tests whether the array or slice contains the specified element or not, and
@@ -32,7 +31,7 @@ func (a *Assertion) ContainsElement(source, expect any, message ...any) error {
 func (a *Assertion) ContainsElementNow(source, expect any, message ...any) error {
 	a.Helper()
 
-	return tryContainsElement(a.T, true, source, expect, message...)
+	return tryContainsElement(a.testingT(), true, source, expect, message...)
 }
 
 // NotContainsElement tests whether the array or slice contains the specified element or not, and
@@ -46,7 +45,7 @@ func (a *Assertion) ContainsElementNow(source, expect any, message ...any) error
 func (a *Assertion) NotContainsElement(source, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotContainsElement(a.T, false, source, expect, message...)
+	return tryNotContainsElement(a.testingT(), false, source, expect, message...)
 }
 
 // NotContainsElementNow tests whether the array or slice contains the specified element or not,
@@ -61,13 +60,13 @@ func (a *Assertion) NotContainsElement(source, expect any, message ...any) error
 func (a *Assertion) NotContainsElementNow(source, expect any, message ...any) error {
 	a.Helper()
 
-	return tryNotContainsElement(a.T, true, source, expect, message...)
+	return tryNotContainsElement(a.testingT(), true, source, expect, message...)
 }
 
 // tryContainsElement tries to test whether the array or slice contains the specified element or
 // not, and it'll fail if the array or slice does not contains the specified element.
 func tryContainsElement(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	src, elem any,
 	message ...any,
@@ -86,7 +85,7 @@ func tryContainsElement(
 // tryNotContainsElement tries to test whether the array or slice contains the specified element
 // or not, and it'll fail if the array of slice contains the specified element.
 func tryNotContainsElement(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	src, elem any,
 	message ...any,
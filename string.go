@@ -3,8 +3,24 @@ package assert
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-	"testing"
+	"sync"
+	"unicode"
+)
+
+const (
+	// maxRegexpCacheSize is the maximum number of the compiled regular expressions in the cache.
+	maxRegexpCacheSize = 256
+)
+
+var (
+	// regexpCache is the cache of the compiled regular expressions by their patterns, and it'll be
+	// reset if the number of the patterns exceeds maxRegexpCacheSize.
+	regexpCache = make(map[string]*regexp.Regexp)
+	// regexpCacheMu is the lock of the regexpCache.
+	regexpCacheMu sync.RWMutex
 )
 
 // ContainsString tests whether the string contains the substring or not, and it set the result to
@@ -18,7 +34,7 @@ import (
 func (a *Assertion) ContainsString(str, substr string, message ...any) error {
 	a.Helper()
 
-	return tryContainsString(a.T, false, str, substr, message...)
+	return tryContainsString(a.testingT(), false, str, substr, message...)
 }
 
 // ContainsStringNow tests whether the string contains the substring or not, and it will terminate the
@@ -33,7 +49,7 @@ func (a *Assertion) ContainsString(str, substr string, message ...any) error {
 func (a *Assertion) ContainsStringNow(str, substr string, message ...any) error {
 	a.Helper()
 
-	return tryContainsString(a.T, true, str, substr, message...)
+	return tryContainsString(a.testingT(), true, str, substr, message...)
 }
 
 // NotContainsString tests whether the string contains the substring or not, and it set the result
@@ -47,7 +63,7 @@ func (a *Assertion) ContainsStringNow(str, substr string, message ...any) error
 func (a *Assertion) NotContainsString(str, substr string, message ...any) error {
 	a.Helper()
 
-	return tryNotContainsString(a.T, false, str, substr, message...)
+	return tryNotContainsString(a.testingT(), false, str, substr, message...)
 }
 
 // NotContainsStringNow tests whether the string contains the substring or not, and it will terminate the
@@ -60,13 +76,13 @@ func (a *Assertion) NotContainsString(str, substr string, message ...any) error
 func (a *Assertion) NotContainsStringNow(str, substr string, message ...any) error {
 	a.Helper()
 
-	return tryNotContainsString(a.T, true, str, substr, message...)
+	return tryNotContainsString(a.testingT(), true, str, substr, message...)
 }
 
 // tryContainsString tries to test whether the string contains the substring or not, and it'll
 // fail if the string does not contains the substring.
 func tryContainsString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, substr string,
 	message ...any,
@@ -85,7 +101,7 @@ func tryContainsString(
 // tryNotContainsString tries to test whether the string contains the substring or not, and it'll
 // fail if the string contains the substring.
 func tryNotContainsString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, substr string,
 	message ...any,
@@ -112,7 +128,7 @@ func tryNotContainsString(
 func (a *Assertion) HasPrefixString(str, prefix string, message ...any) error {
 	a.Helper()
 
-	return tryHasPrefixString(a.T, false, str, prefix, message...)
+	return tryHasPrefixString(a.testingT(), false, str, prefix, message...)
 }
 
 // HasPrefixStringNow tests whether the string has the prefix string or not, and it will terminate
@@ -126,7 +142,7 @@ func (a *Assertion) HasPrefixString(str, prefix string, message ...any) error {
 func (a *Assertion) HasPrefixStringNow(str, prefix string, message ...any) error {
 	a.Helper()
 
-	return tryHasPrefixString(a.T, true, str, prefix, message...)
+	return tryHasPrefixString(a.testingT(), true, str, prefix, message...)
 }
 
 // NotHasPrefixString tests whether the string has the prefix string or not, and it set the result
@@ -140,7 +156,7 @@ func (a *Assertion) HasPrefixStringNow(str, prefix string, message ...any) error
 func (a *Assertion) NotHasPrefixString(str, prefix string, message ...any) error {
 	a.Helper()
 
-	return tryNotHasPrefixString(a.T, false, str, prefix, message...)
+	return tryNotHasPrefixString(a.testingT(), false, str, prefix, message...)
 }
 
 // NotHasPrefixStringNow tests whether the string has the prefix string or not, and it will
@@ -154,13 +170,13 @@ func (a *Assertion) NotHasPrefixString(str, prefix string, message ...any) error
 func (a *Assertion) NotHasPrefixStringNow(str, prefix string, message ...any) error {
 	a.Helper()
 
-	return tryNotHasPrefixString(a.T, true, str, prefix, message...)
+	return tryNotHasPrefixString(a.testingT(), true, str, prefix, message...)
 }
 
 // tryHasPrefixString tries to test whether the string has the prefix string or not, and it'll fail
 // if the string does not have the prefix string.
 func tryHasPrefixString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, prefix string,
 	message ...any,
@@ -179,7 +195,7 @@ func tryHasPrefixString(
 // tryNotHasPrefixString tries to test whether the string has the prefix string or not, and it'll
 // fail if the string has the prefix string.
 func tryNotHasPrefixString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, prefix string,
 	message ...any,
@@ -206,7 +222,7 @@ func tryNotHasPrefixString(
 func (a *Assertion) HasSuffixString(str, suffix string, message ...any) error {
 	a.Helper()
 
-	return tryHasSuffixString(a.T, false, str, suffix, message...)
+	return tryHasSuffixString(a.testingT(), false, str, suffix, message...)
 }
 
 // HasSuffixStringNow tests whether the string has the suffix string or not, and it will terminate
@@ -220,7 +236,7 @@ func (a *Assertion) HasSuffixString(str, suffix string, message ...any) error {
 func (a *Assertion) HasSuffixStringNow(str, suffix string, message ...any) error {
 	a.Helper()
 
-	return tryHasSuffixString(a.T, true, str, suffix, message...)
+	return tryHasSuffixString(a.testingT(), true, str, suffix, message...)
 }
 
 // NotHasSuffixString tests whether the string has the suffix string or not, and it set the result
@@ -234,7 +250,7 @@ func (a *Assertion) HasSuffixStringNow(str, suffix string, message ...any) error
 func (a *Assertion) NotHasSuffixString(str, suffix string, message ...any) error {
 	a.Helper()
 
-	return tryNotHasSuffixString(a.T, false, str, suffix, message...)
+	return tryNotHasSuffixString(a.testingT(), false, str, suffix, message...)
 }
 
 // NotHasSuffixStringNow tests whether the string has the suffix string or not, and it will
@@ -248,13 +264,13 @@ func (a *Assertion) NotHasSuffixString(str, suffix string, message ...any) error
 func (a *Assertion) NotHasSuffixStringNow(str, suffix string, message ...any) error {
 	a.Helper()
 
-	return tryNotHasSuffixString(a.T, true, str, suffix, message...)
+	return tryNotHasSuffixString(a.testingT(), true, str, suffix, message...)
 }
 
 // tryHasSuffixString tries to test whether the string has the suffix string or not, and it'll fail
 // if the string does not have the suffix string.
 func tryHasSuffixString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, suffix string,
 	message ...any,
@@ -273,7 +289,7 @@ func tryHasSuffixString(
 // tryNotHasSuffixString tries to test whether the string has the suffix string or not, and it'll
 // fail if the string has the suffix string.
 func tryNotHasSuffixString(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	str, suffix string,
 	message ...any,
@@ -298,7 +314,7 @@ func tryNotHasSuffixString(
 func (a *Assertion) Match(val string, pattern *regexp.Regexp, message ...any) error {
 	a.Helper()
 
-	return tryMatchRegexp(a.T, false, val, pattern, "", message...)
+	return tryMatchRegexp(a.testingT(), false, val, pattern, "", message...)
 }
 
 // MatchNow tests whether the string matches the regular expression or not, and it will terminate
@@ -312,7 +328,7 @@ func (a *Assertion) Match(val string, pattern *regexp.Regexp, message ...any) er
 func (a *Assertion) MatchNow(val string, pattern *regexp.Regexp, message ...any) error {
 	a.Helper()
 
-	return tryMatchRegexp(a.T, true, val, pattern, "", message...)
+	return tryMatchRegexp(a.testingT(), true, val, pattern, "", message...)
 }
 
 // MatchString will compile the pattern and test whether the string matches the regular expression
@@ -324,7 +340,7 @@ func (a *Assertion) MatchNow(val string, pattern *regexp.Regexp, message ...any)
 func (a *Assertion) MatchString(val, pattern string, message ...any) error {
 	a.Helper()
 
-	return tryMatchRegexp(a.T, false, val, nil, pattern, message...)
+	return tryMatchRegexp(a.testingT(), false, val, nil, pattern, message...)
 }
 
 // MatchStringNow will compile the pattern and test whether the string matches the regular
@@ -338,7 +354,7 @@ func (a *Assertion) MatchString(val, pattern string, message ...any) error {
 func (a *Assertion) MatchStringNow(val, pattern string, message ...any) error {
 	a.Helper()
 
-	return tryMatchRegexp(a.T, true, val, nil, pattern, message...)
+	return tryMatchRegexp(a.testingT(), true, val, nil, pattern, message...)
 }
 
 // NotMatch tests whether the string matches the regular expression or not, and it set the result
@@ -351,7 +367,7 @@ func (a *Assertion) MatchStringNow(val, pattern string, message ...any) error {
 func (a *Assertion) NotMatch(val string, pattern *regexp.Regexp, message ...any) error {
 	a.Helper()
 
-	return tryNotMatchRegexp(a.T, false, val, pattern, "", message...)
+	return tryNotMatchRegexp(a.testingT(), false, val, pattern, "", message...)
 }
 
 // NotMatchNow tests whether the string matches the regular expression or not, and it will
@@ -365,7 +381,7 @@ func (a *Assertion) NotMatch(val string, pattern *regexp.Regexp, message ...any)
 func (a *Assertion) NotMatchNow(val string, pattern *regexp.Regexp, message ...any) error {
 	a.Helper()
 
-	return tryNotMatchRegexp(a.T, true, val, pattern, "", message...)
+	return tryNotMatchRegexp(a.testingT(), true, val, pattern, "", message...)
 }
 
 // MatchString will compile the pattern and test whether the string matches the regular expression
@@ -378,7 +394,7 @@ func (a *Assertion) NotMatchNow(val string, pattern *regexp.Regexp, message ...a
 func (a *Assertion) NotMatchString(val, pattern string, message ...any) error {
 	a.Helper()
 
-	return tryNotMatchRegexp(a.T, false, val, nil, pattern, message...)
+	return tryNotMatchRegexp(a.testingT(), false, val, nil, pattern, message...)
 }
 
 // NotMatchStringNow will compile the pattern and test whether the string matches the regular
@@ -393,13 +409,143 @@ func (a *Assertion) NotMatchString(val, pattern string, message ...any) error {
 func (a *Assertion) NotMatchStringNow(val, pattern string, message ...any) error {
 	a.Helper()
 
-	return tryNotMatchRegexp(a.T, true, val, nil, pattern, message...)
+	return tryNotMatchRegexp(a.testingT(), true, val, nil, pattern, message...)
+}
+
+// FullMatch tests whether the whole string matches the regular expression, and it set the result
+// to fail if the pattern does not match the entire string.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`[a-z]+`)
+//	a.FullMatch("hello", pattern) // success
+//	a.FullMatch("hello world", pattern) // fail
+func (a *Assertion) FullMatch(val string, pattern *regexp.Regexp, message ...any) error {
+	a.Helper()
+
+	return tryFullMatch(a.testingT(), false, val, pattern, "", message...)
+}
+
+// FullMatchNow tests whether the whole string matches the regular expression, and it will
+// terminate the execution if the pattern does not match the entire string.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`[a-z]+`)
+//	a.FullMatchNow("hello", pattern) // success
+//	a.FullMatchNow("hello world", pattern) // fail and terminate
+//	// never runs
+func (a *Assertion) FullMatchNow(val string, pattern *regexp.Regexp, message ...any) error {
+	a.Helper()
+
+	return tryFullMatch(a.testingT(), true, val, pattern, "", message...)
+}
+
+// FullMatchString will compile the pattern and test whether the whole string matches the regular
+// expression. It will panic if the pattern is not a valid regular expression.
+//
+//	a := assert.New(t)
+//	a.FullMatchString("hello", `[a-z]+`) // success
+//	a.FullMatchString("hello world", `[a-z]+`) // fail
+func (a *Assertion) FullMatchString(val, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryFullMatch(a.testingT(), false, val, nil, pattern, message...)
+}
+
+// FullMatchStringNow will compile the pattern and test whether the whole string matches the
+// regular expression. It will terminate the execution if the pattern does not match the entire
+// string, and it will panic if the pattern is not a valid regular expression.
+//
+//	a := assert.New(t)
+//	a.FullMatchStringNow("hello", `[a-z]+`) // success
+//	a.FullMatchStringNow("hello world", `[a-z]+`) // fail and terminate
+//	// never runs
+func (a *Assertion) FullMatchStringNow(val, pattern string, message ...any) error {
+	a.Helper()
+
+	return tryFullMatch(a.testingT(), true, val, nil, pattern, message...)
+}
+
+// MatchCount tests whether the number of the non-overlapping matches of the regular expression in
+// the string equals to n, and it set the result to fail if the number is not n.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`\d+`)
+//	a.MatchCount("1, 22, 333", pattern, 3) // success
+//	a.MatchCount("1, 22, 333", pattern, 2) // fail
+func (a *Assertion) MatchCount(val string, pattern *regexp.Regexp, n int, message ...any) error {
+	a.Helper()
+
+	return tryMatchCount(a.testingT(), false, val, pattern, n, message...)
+}
+
+// MatchCountNow tests whether the number of the non-overlapping matches of the regular expression
+// in the string equals to n, and it will terminate the execution if the number is not n.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`\d+`)
+//	a.MatchCountNow("1, 22, 333", pattern, 3) // success
+//	a.MatchCountNow("1, 22, 333", pattern, 2) // fail and terminate
+//	// never runs
+func (a *Assertion) MatchCountNow(
+	val string,
+	pattern *regexp.Regexp,
+	n int,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryMatchCount(a.testingT(), true, val, pattern, n, message...)
+}
+
+// MatchGroups tests whether the string matches the regular expression, and the capture groups of
+// the first match are the expected values. The keys of the expected values are the names or the
+// numbers of the groups, and the groups that are not in the expected values are not checked. It
+// returns the submatches of the first match, and the index 0 is the text of the whole match. It'll
+// set the result to fail if the string does not match, or any group is not the expected value.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`(?P<year>\d{4})-(\d{2})`)
+//	groups, _ := a.MatchGroups("2024-05", pattern, map[string]string{
+//	  "year": "2024",
+//	  "2":    "05",
+//	}) // success, groups is ["2024-05", "2024", "05"]
+//	a.MatchGroups("2024-05", pattern, map[string]string{"year": "2023"}) // fail
+func (a *Assertion) MatchGroups(
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	message ...any,
+) ([]string, error) {
+	a.Helper()
+
+	return tryMatchGroups(a.testingT(), false, val, pattern, expected, message...)
+}
+
+// MatchGroupsNow tests whether the string matches the regular expression, and the capture groups
+// of the first match are the expected values. It returns the submatches of the first match, and
+// it will terminate the execution if the string does not match, or any group is not the expected
+// value.
+//
+//	a := assert.New(t)
+//	pattern := regexp.MustCompile(`(?P<year>\d{4})-(\d{2})`)
+//	a.MatchGroupsNow("2024-05", pattern, map[string]string{"year": "2024"}) // success
+//	a.MatchGroupsNow("2024-05", pattern, map[string]string{"year": "2023"}) // fail and terminate
+//	// never runs
+func (a *Assertion) MatchGroupsNow(
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	message ...any,
+) ([]string, error) {
+	a.Helper()
+
+	return tryMatchGroups(a.testingT(), true, val, pattern, expected, message...)
 }
 
 // tryMatchRegexp tries to test whether the string matches the regular expression pattern or not,
 // and it'll fail if the string does not match.
 func tryMatchRegexp(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	val string,
 	pattern *regexp.Regexp,
@@ -409,14 +555,14 @@ func tryMatchRegexp(
 	t.Helper()
 
 	if pattern == nil {
-		pattern = regexp.MustCompile(patternStr)
+		pattern = getRegexp(patternStr)
 	}
 
 	return test(
 		t,
-		func() bool { return pattern.Match([]byte(val)) },
+		func() bool { return pattern.MatchString(val) },
 		failedNow,
-		defaultErrMessageMatch,
+		fmt.Sprintf(defaultErrMessageMatch, val, pattern),
 		message...,
 	)
 }
@@ -424,7 +570,7 @@ func tryMatchRegexp(
 // tryNotMatchRegexp tries to test whether the string matches the regular expression pattern or
 // not, and it'll fail if the string matches the pattern.
 func tryNotMatchRegexp(
-	t *testing.T,
+	t testingT,
 	failedNow bool,
 	val string,
 	pattern *regexp.Regexp,
@@ -434,14 +580,799 @@ func tryNotMatchRegexp(
 	t.Helper()
 
 	if pattern == nil {
-		pattern = regexp.MustCompile(patternStr)
+		pattern = getRegexp(patternStr)
 	}
 
 	return test(
 		t,
-		func() bool { return !pattern.Match([]byte(val)) },
+		func() bool { return !pattern.MatchString(val) },
 		failedNow,
-		defaultErrMessageNotMatch,
+		fmt.Sprintf(defaultErrMessageNotMatch, val, pattern),
 		message...,
 	)
 }
+
+// tryFullMatch tries to test whether the whole string matches the regular expression pattern, and
+// it'll fail if the pattern does not match the entire string.
+func tryFullMatch(
+	t testingT,
+	failedNow bool,
+	val string,
+	pattern *regexp.Regexp,
+	patternStr string,
+	message ...any,
+) error {
+	t.Helper()
+
+	if pattern == nil {
+		pattern = getRegexp(patternStr)
+	}
+	// anchors the pattern at both ends rather than checking the bounds of the leftmost-first match,
+	// so the alternations like `a|ab` can fully match "ab".
+	fullPattern := getRegexp("^(?:" + pattern.String() + ")$")
+	isFullMatch := fullPattern.MatchString(val)
+
+	return test(
+		t,
+		func() bool { return isFullMatch },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageFullMatch, val, pattern),
+		message...,
+	)
+}
+
+// tryMatchCount tries to test whether the number of the matches of the regular expression pattern
+// in the string equals to n, and it'll fail if the number is not n.
+func tryMatchCount(
+	t testingT,
+	failedNow bool,
+	val string,
+	pattern *regexp.Regexp,
+	n int,
+	message ...any,
+) error {
+	t.Helper()
+
+	count := len(pattern.FindAllStringIndex(val, -1))
+
+	return test(
+		t,
+		func() bool { return count == n },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageMatchCount, n, pattern, val, count),
+		message...,
+	)
+}
+
+// tryMatchGroups tries to test whether the string matches the regular expression pattern and the
+// capture groups are the expected values, and it'll fail if the string does not match, or any
+// group is not the expected value.
+func tryMatchGroups(
+	t testingT,
+	failedNow bool,
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	message ...any,
+) ([]string, error) {
+	t.Helper()
+
+	groups := pattern.FindStringSubmatch(val)
+
+	defaultMessage := fmt.Sprintf(defaultErrMessageMatch, val, pattern)
+	differences := make([]string, 0)
+	if groups != nil {
+		differences = getGroupDifferences(pattern, groups, expected)
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageMatchGroups, val, pattern, strings.Join(differences, "\n"),
+		)
+	}
+
+	err := test(
+		t,
+		func() bool { return groups != nil && len(differences) == 0 },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+
+	return groups, err
+}
+
+// getGroupDifferences compares the capture groups with the expected values, and describes the
+// groups that do not exist in the pattern or are not the expected values.
+func getGroupDifferences(
+	pattern *regexp.Regexp,
+	groups []string,
+	expected map[string]string,
+) []string {
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	differences := make([]string, 0)
+	for _, key := range keys {
+		i := pattern.SubexpIndex(key)
+		if i < 0 {
+			if n, err := strconv.Atoi(key); err == nil && n >= 0 && n < len(groups) {
+				i = n
+			}
+		}
+
+		if i < 0 {
+			differences = append(differences, fmt.Sprintf("  %s: no such group", key))
+		} else if groups[i] != expected[key] {
+			differences = append(differences, fmt.Sprintf(
+				"  %s: expect %q, got %q", key, expected[key], groups[i],
+			))
+		}
+	}
+
+	return differences
+}
+
+// getRegexp returns the compiled regular expression of the pattern from the cache, and it'll
+// compile and cache the pattern if it is not in the cache. The cache will be reset if it's full. It
+// will panic if the pattern is not a valid regular expression.
+func getRegexp(pattern string) *regexp.Regexp {
+	regexpCacheMu.RLock()
+	re, ok := regexpCache[pattern]
+	regexpCacheMu.RUnlock()
+	if ok {
+		return re
+	}
+
+	re = regexp.MustCompile(pattern)
+
+	regexpCacheMu.Lock()
+	if len(regexpCache) >= maxRegexpCacheSize {
+		regexpCache = make(map[string]*regexp.Regexp)
+	}
+	regexpCache[pattern] = re
+	regexpCacheMu.Unlock()
+
+	return re
+}
+
+// TextEqual tests whether the text is the expected text. It'll set the result to fail with the
+// line-based diff of the texts, and the invisible differences like the trailing whitespaces, the
+// tabs and spaces, and the line terminators will be visualized in the diff.
+//
+//	a := assert.New(t)
+//	a.TextEqual("Hello\nworld\n", "Hello\nworld\n") // success
+//	a.TextEqual("Hello\nworld\n", "Hello\nthere\n") // fail
+//	a.TextEqual("Hello\r\nworld\n", "Hello\nworld\n") // fail
+func (a *Assertion) TextEqual(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryTextEqual(a.testingT(), false, actual, expected, message...)
+}
+
+// TextEqualNow tests whether the text is the expected text. It'll terminate the execution with
+// the line-based diff of the texts if the text is not the expected text.
+//
+//	a := assert.New(t)
+//	a.TextEqualNow("Hello\nworld\n", "Hello\nworld\n") // success
+//	a.TextEqualNow("Hello\nworld\n", "Hello\nthere\n") // fail and terminate
+//	// never runs
+func (a *Assertion) TextEqualNow(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryTextEqual(a.testingT(), true, actual, expected, message...)
+}
+
+// tryTextEqual tries to test whether the text is the expected text, and it'll fail with the diff
+// if the texts are not the same.
+func tryTextEqual(t testingT, failedNow bool, actual, expected string, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return actual == expected },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageTextEqual, formatTextDiff(expected, actual)),
+		message...,
+	)
+}
+
+// EqualFold tests whether the strings are equal under Unicode case-folding. It'll set the result to
+// fail with the case-folded forms of the strings if they are not equal ignoring case.
+//
+//	a := assert.New(t)
+//	a.EqualFold("Hello World", "hello world") // success
+//	a.EqualFold("Hello World", "HELLO WORLD") // success
+//	a.EqualFold("Hello World", "Hello there") // fail
+func (a *Assertion) EqualFold(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), false, actual, expected, foldString, defaultErrMessageEqualFold, message...,
+	)
+}
+
+// EqualFoldNow tests whether the strings are equal under Unicode case-folding. It'll terminate the
+// execution if they are not equal ignoring case.
+//
+//	a := assert.New(t)
+//	a.EqualFoldNow("Hello World", "hello world") // success
+//	a.EqualFoldNow("Hello World", "Hello there") // fail and terminate
+//	// never runs
+func (a *Assertion) EqualFoldNow(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), true, actual, expected, foldString, defaultErrMessageEqualFold, message...,
+	)
+}
+
+// EqualIgnoringWhitespace tests whether the strings are equal after collapsing every run of
+// whitespace characters into a single space, and trimming the leading and trailing whitespaces.
+// It'll set the result to fail with the normalized forms of the strings if they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualIgnoringWhitespace("SELECT *\n  FROM users", "SELECT * FROM users") // success
+//	a.EqualIgnoringWhitespace(" Hello\tworld ", "Hello world") // success
+//	a.EqualIgnoringWhitespace("Helloworld", "Hello world") // fail
+func (a *Assertion) EqualIgnoringWhitespace(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), false, actual, expected, collapseWhitespaces,
+		defaultErrMessageEqualIgnoreSpace, message...,
+	)
+}
+
+// EqualIgnoringWhitespaceNow tests whether the strings are equal after collapsing the whitespaces.
+// It'll terminate the execution if they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualIgnoringWhitespaceNow("SELECT *\n  FROM users", "SELECT * FROM users") // success
+//	a.EqualIgnoringWhitespaceNow("Helloworld", "Hello world") // fail and terminate
+//	// never runs
+func (a *Assertion) EqualIgnoringWhitespaceNow(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), true, actual, expected, collapseWhitespaces,
+		defaultErrMessageEqualIgnoreSpace, message...,
+	)
+}
+
+// EqualNormalizedNewlines tests whether the strings are equal after converting the line
+// terminators "\r\n" and "\r" to "\n". It'll set the result to fail with the normalized forms of
+// the strings if they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualNormalizedNewlines("Hello\r\nworld\r\n", "Hello\nworld\n") // success
+//	a.EqualNormalizedNewlines("Hello\r\nworld", "Hello\nworld\n") // fail
+func (a *Assertion) EqualNormalizedNewlines(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), false, actual, expected, normalizeNewlines,
+		defaultErrMessageEqualNewlines, message...,
+	)
+}
+
+// EqualNormalizedNewlinesNow tests whether the strings are equal after normalizing the line
+// terminators. It'll terminate the execution if they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualNormalizedNewlinesNow("Hello\r\nworld\r\n", "Hello\nworld\n") // success
+//	a.EqualNormalizedNewlinesNow("Hello\r\nworld", "Hello\nworld\n") // fail and terminate
+//	// never runs
+func (a *Assertion) EqualNormalizedNewlinesNow(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), true, actual, expected, normalizeNewlines,
+		defaultErrMessageEqualNewlines, message...,
+	)
+}
+
+// EqualIgnoringIndentation tests whether the strings are equal after removing the leading spaces
+// and tabs of every line. It'll set the result to fail with the normalized forms of the strings if
+// they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualIgnoringIndentation("<p>\n  Hi\n</p>", "<p>\nHi\n</p>") // success
+//	a.EqualIgnoringIndentation("<p>\n  Hi\n</p>", "<p>Hi</p>") // fail
+func (a *Assertion) EqualIgnoringIndentation(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), false, actual, expected, removeIndentation,
+		defaultErrMessageEqualIgnoreIndent, message...,
+	)
+}
+
+// EqualIgnoringIndentationNow tests whether the strings are equal after removing the indentation
+// of every line. It'll terminate the execution if they are not equal.
+//
+//	a := assert.New(t)
+//	a.EqualIgnoringIndentationNow("<p>\n  Hi\n</p>", "<p>\nHi\n</p>") // success
+//	a.EqualIgnoringIndentationNow("<p>\n  Hi\n</p>", "<p>Hi</p>") // fail and terminate
+//	// never runs
+func (a *Assertion) EqualIgnoringIndentationNow(actual, expected string, message ...any) error {
+	a.Helper()
+
+	return tryNormalizedEqual(
+		a.testingT(), true, actual, expected, removeIndentation,
+		defaultErrMessageEqualIgnoreIndent, message...,
+	)
+}
+
+// tryNormalizedEqual tries to test whether the strings are equal after normalized by the function,
+// and it'll fail with the normalized forms if they are not equal.
+func tryNormalizedEqual(
+	t testingT,
+	failedNow bool,
+	actual, expected string,
+	normalize func(string) string,
+	defaultMessage string,
+	message ...any,
+) error {
+	t.Helper()
+
+	normalizedActual := normalize(actual)
+	normalizedExpected := normalize(expected)
+
+	return test(
+		t,
+		func() bool { return normalizedActual == normalizedExpected },
+		failedNow,
+		fmt.Sprintf(defaultMessage, formatNormalizedStrings(normalizedActual, normalizedExpected)),
+		message...,
+	)
+}
+
+// formatNormalizedStrings formats the normalized strings for the failure messages. It'll be the
+// line-based diff if any of the strings has multiple lines, or the quoted strings otherwise.
+func formatNormalizedStrings(actual, expected string) string {
+	if strings.Contains(actual, "\n") || strings.Contains(expected, "\n") {
+		return formatTextDiff(expected, actual)
+	}
+
+	return fmt.Sprintf("expected: %q\nactual:   %q", expected, actual)
+}
+
+// collapseWhitespaces replaces every run of the whitespace characters with a single space, and
+// removes the leading and trailing whitespaces.
+func collapseWhitespaces(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// normalizeNewlines converts the line terminators "\r\n" and "\r" to "\n".
+func normalizeNewlines(s string) string {
+	return strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(s)
+}
+
+// removeIndentation removes the leading spaces and tabs of every line.
+func removeIndentation(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimLeft(line, " \t")
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// ContainsStringFold tests whether the string contains the substring under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// contain the substring ignoring case.
+//
+//	a := assert.New(t)
+//	a.ContainsStringFold("Hello World", "world") // success
+//	a.ContainsStringFold("Hello World", "O W") // success
+//	a.ContainsStringFold("Hello World", "there") // fail
+func (a *Assertion) ContainsStringFold(str, substr string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), false, str, substr, strings.Contains,
+		defaultErrMessageContainsFold, message...,
+	)
+}
+
+// ContainsStringFoldNow tests whether the string contains the substring under Unicode
+// case-folding. It'll terminate the execution if the string does not contain the substring
+// ignoring case.
+//
+//	a := assert.New(t)
+//	a.ContainsStringFoldNow("Hello World", "world") // success
+//	a.ContainsStringFoldNow("Hello World", "there") // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsStringFoldNow(str, substr string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), true, str, substr, strings.Contains,
+		defaultErrMessageContainsFold, message...,
+	)
+}
+
+// HasPrefixStringFold tests whether the string has the prefix string under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// have the prefix ignoring case.
+//
+//	a := assert.New(t)
+//	a.HasPrefixStringFold("Hello World", "hello") // success
+//	a.HasPrefixStringFold("Hello World", "world") // fail
+func (a *Assertion) HasPrefixStringFold(str, prefix string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), false, str, prefix, strings.HasPrefix,
+		defaultErrMessageHasPrefixFold, message...,
+	)
+}
+
+// HasPrefixStringFoldNow tests whether the string has the prefix string under Unicode
+// case-folding. It'll terminate the execution if the string does not have the prefix ignoring
+// case.
+//
+//	a := assert.New(t)
+//	a.HasPrefixStringFoldNow("Hello World", "hello") // success
+//	a.HasPrefixStringFoldNow("Hello World", "world") // fail and terminate
+//	// never runs
+func (a *Assertion) HasPrefixStringFoldNow(str, prefix string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), true, str, prefix, strings.HasPrefix,
+		defaultErrMessageHasPrefixFold, message...,
+	)
+}
+
+// HasSuffixStringFold tests whether the string has the suffix string under Unicode case-folding.
+// It'll set the result to fail with the case-folded forms of the strings if the string does not
+// have the suffix ignoring case.
+//
+//	a := assert.New(t)
+//	a.HasSuffixStringFold("Hello World", "WORLD") // success
+//	a.HasSuffixStringFold("Hello World", "hello") // fail
+func (a *Assertion) HasSuffixStringFold(str, suffix string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), false, str, suffix, strings.HasSuffix,
+		defaultErrMessageHasSuffixFold, message...,
+	)
+}
+
+// HasSuffixStringFoldNow tests whether the string has the suffix string under Unicode
+// case-folding. It'll terminate the execution if the string does not have the suffix ignoring
+// case.
+//
+//	a := assert.New(t)
+//	a.HasSuffixStringFoldNow("Hello World", "WORLD") // success
+//	a.HasSuffixStringFoldNow("Hello World", "hello") // fail and terminate
+//	// never runs
+func (a *Assertion) HasSuffixStringFoldNow(str, suffix string, message ...any) error {
+	a.Helper()
+
+	return tryStringFold(
+		a.testingT(), true, str, suffix, strings.HasSuffix,
+		defaultErrMessageHasSuffixFold, message...,
+	)
+}
+
+// tryStringFold tries to test the string and the substring by the function after folding their
+// cases, and it'll fail with the folded forms if the function returns false.
+func tryStringFold(
+	t testingT,
+	failedNow bool,
+	str, substr string,
+	fn func(s, substr string) bool,
+	defaultMessage string,
+	message ...any,
+) error {
+	t.Helper()
+
+	foldedStr := foldString(str)
+	foldedSubstr := foldString(substr)
+
+	return test(
+		t,
+		func() bool { return fn(foldedStr, foldedSubstr) },
+		failedNow,
+		fmt.Sprintf(defaultMessage, foldedSubstr, foldedStr),
+		message...,
+	)
+}
+
+// foldString returns the case-folded form of the string, which maps every rune to the lower case
+// form of its Unicode case-folding orbit, so the strings that are equal under `strings.EqualFold`
+// have the same folded form.
+func foldString(s string) string {
+	return strings.Map(func(r rune) rune {
+		folded := unicode.ToLower(r)
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if lower := unicode.ToLower(f); lower < folded {
+				folded = lower
+			}
+		}
+		return folded
+	}, s)
+}
+
+// ContainsAllStrings tests whether the string contains all the substrings, and it set the result
+// to fail with the missing substrings if the string does not contain any of them.
+//
+//	a := assert.New(t)
+//	a.ContainsAllStrings("Hello world", []string{"Hello", "world"}) // success
+//	a.ContainsAllStrings("Hello world", []string{"Hello", "there"}) // fail
+func (a *Assertion) ContainsAllStrings(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsAllStrings(a.testingT(), false, str, substrs, message...)
+}
+
+// ContainsAllStringsNow tests whether the string contains all the substrings, and it will
+// terminate the execution if the string does not contain any of them.
+//
+//	a := assert.New(t)
+//	a.ContainsAllStringsNow("Hello world", []string{"Hello", "world"}) // success
+//	a.ContainsAllStringsNow("Hello world", []string{"Hello", "there"}) // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsAllStringsNow(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsAllStrings(a.testingT(), true, str, substrs, message...)
+}
+
+// ContainsAnyString tests whether the string contains at least one of the substrings, and it set
+// the result to fail if the string contains none of them.
+//
+//	a := assert.New(t)
+//	a.ContainsAnyString("Hello world", []string{"there", "world"}) // success
+//	a.ContainsAnyString("Hello world", []string{"there", "hi"}) // fail
+func (a *Assertion) ContainsAnyString(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsAnyString(a.testingT(), false, str, substrs, message...)
+}
+
+// ContainsAnyStringNow tests whether the string contains at least one of the substrings, and it
+// will terminate the execution if the string contains none of them.
+//
+//	a := assert.New(t)
+//	a.ContainsAnyStringNow("Hello world", []string{"there", "world"}) // success
+//	a.ContainsAnyStringNow("Hello world", []string{"there", "hi"}) // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsAnyStringNow(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsAnyString(a.testingT(), true, str, substrs, message...)
+}
+
+// ContainsNoneStrings tests whether the string contains none of the substrings, and it set the
+// result to fail with the found substrings and their offsets if the string contains any of them.
+//
+//	a := assert.New(t)
+//	a.ContainsNoneStrings("Hello world", []string{"there", "hi"}) // success
+//	a.ContainsNoneStrings("Hello world", []string{"there", "world"}) // fail
+func (a *Assertion) ContainsNoneStrings(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsNoneStrings(a.testingT(), false, str, substrs, message...)
+}
+
+// ContainsNoneStringsNow tests whether the string contains none of the substrings, and it will
+// terminate the execution if the string contains any of them.
+//
+//	a := assert.New(t)
+//	a.ContainsNoneStringsNow("Hello world", []string{"there", "hi"}) // success
+//	a.ContainsNoneStringsNow("Hello world", []string{"there", "world"}) // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsNoneStringsNow(str string, substrs []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsNoneStrings(a.testingT(), true, str, substrs, message...)
+}
+
+// StringCount tests whether the number of the non-overlapping occurrences of the substring in the
+// string equals to n, and it set the result to fail if the number is not n.
+//
+//	a := assert.New(t)
+//	a.StringCount("cheese", "e", 3) // success
+//	a.StringCount("cheese", "e", 2) // fail
+func (a *Assertion) StringCount(str, substr string, n int, message ...any) error {
+	a.Helper()
+
+	return tryStringCount(a.testingT(), false, str, substr, n, message...)
+}
+
+// StringCountNow tests whether the number of the non-overlapping occurrences of the substring in
+// the string equals to n, and it will terminate the execution if the number is not n.
+//
+//	a := assert.New(t)
+//	a.StringCountNow("cheese", "e", 3) // success
+//	a.StringCountNow("cheese", "e", 2) // fail and terminate
+//	// never runs
+func (a *Assertion) StringCountNow(str, substr string, n int, message ...any) error {
+	a.Helper()
+
+	return tryStringCount(a.testingT(), true, str, substr, n, message...)
+}
+
+// ContainsInOrder tests whether the parts appear in the string in sequence without overlapping,
+// and it set the result to fail with the first missing or out of order part, and the offset of the
+// last matched part.
+//
+//	a := assert.New(t)
+//	a.ContainsInOrder("step 1, step 2, step 3", []string{"1", "2", "3"}) // success
+//	a.ContainsInOrder("step 1, step 2, step 3", []string{"1", "3", "2"}) // fail
+func (a *Assertion) ContainsInOrder(str string, parts []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsInOrder(a.testingT(), false, str, parts, message...)
+}
+
+// ContainsInOrderNow tests whether the parts appear in the string in sequence without
+// overlapping, and it will terminate the execution if any part is missing or out of order.
+//
+//	a := assert.New(t)
+//	a.ContainsInOrderNow("step 1, step 2, step 3", []string{"1", "2", "3"}) // success
+//	a.ContainsInOrderNow("step 1, step 2, step 3", []string{"1", "3", "2"}) // fail and terminate
+//	// never runs
+func (a *Assertion) ContainsInOrderNow(str string, parts []string, message ...any) error {
+	a.Helper()
+
+	return tryContainsInOrder(a.testingT(), true, str, parts, message...)
+}
+
+// tryContainsAllStrings tries to test whether the string contains all the substrings, and it'll
+// fail if any substring is missing.
+func tryContainsAllStrings(
+	t testingT,
+	failedNow bool,
+	str string,
+	substrs []string,
+	message ...any,
+) error {
+	t.Helper()
+
+	missing := make([]string, 0)
+	for _, substr := range substrs {
+		if !strings.Contains(str, substr) {
+			missing = append(missing, substr)
+		}
+	}
+
+	return test(
+		t,
+		func() bool { return len(missing) == 0 },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageContainsAllStrings, substrs, missing),
+		message...,
+	)
+}
+
+// tryContainsAnyString tries to test whether the string contains at least one of the substrings,
+// and it'll fail if the string contains none of them.
+func tryContainsAnyString(
+	t testingT,
+	failedNow bool,
+	str string,
+	substrs []string,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool {
+			for _, substr := range substrs {
+				if strings.Contains(str, substr) {
+					return true
+				}
+			}
+			return false
+		},
+		failedNow,
+		fmt.Sprintf(defaultErrMessageContainsAnyString, substrs),
+		message...,
+	)
+}
+
+// tryContainsNoneStrings tries to test whether the string contains none of the substrings, and
+// it'll fail if the string contains any of them.
+func tryContainsNoneStrings(
+	t testingT,
+	failedNow bool,
+	str string,
+	substrs []string,
+	message ...any,
+) error {
+	t.Helper()
+
+	found := make([]string, 0)
+	for _, substr := range substrs {
+		if i := strings.Index(str, substr); i >= 0 {
+			found = append(found, fmt.Sprintf("%q at offset %d", substr, i))
+		}
+	}
+
+	return test(
+		t,
+		func() bool { return len(found) == 0 },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageContainsNoneString, substrs, strings.Join(found, ", ")),
+		message...,
+	)
+}
+
+// tryStringCount tries to test whether the number of the occurrences of the substring in the
+// string equals to n, and it'll fail if the number is not n.
+func tryStringCount(
+	t testingT,
+	failedNow bool,
+	str, substr string,
+	n int,
+	message ...any,
+) error {
+	t.Helper()
+
+	count := strings.Count(str, substr)
+
+	return test(
+		t,
+		func() bool { return count == n },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageStringCount, n, substr, count),
+		message...,
+	)
+}
+
+// tryContainsInOrder tries to test whether the parts appear in the string in sequence, and it'll
+// fail if any part is missing or out of order.
+func tryContainsInOrder(
+	t testingT,
+	failedNow bool,
+	str string,
+	parts []string,
+	message ...any,
+) error {
+	t.Helper()
+
+	detail := findOutOfOrderPart(str, parts)
+
+	return test(
+		t,
+		func() bool { return detail == "" },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageContainsInOrder, parts, detail),
+		message...,
+	)
+}
+
+// findOutOfOrderPart searches the parts in the string in sequence, and describes the first part
+// that is missing or out of order. It returns an empty string if all parts appear in order.
+func findOutOfOrderPart(str string, parts []string) string {
+	offset := 0
+	last := -1
+
+	for i, part := range parts {
+		index := strings.Index(str[offset:], part)
+		if index >= 0 {
+			last = i
+			offset += index + len(part)
+			continue
+		}
+
+		detail := fmt.Sprintf("%q not found", part)
+		if last >= 0 {
+			if index = strings.Index(str, part); index >= 0 {
+				detail = fmt.Sprintf("%q found at offset %d out of order", part, index)
+			}
+			detail += fmt.Sprintf(", the last match %q ends at offset %d", parts[last], offset)
+		}
+
+		return detail
+	}
+
+	return ""
+}
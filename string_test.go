@@ -1,6 +1,7 @@
 package assert
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 )
@@ -244,3 +245,580 @@ func testMatchAndNotMatch(a, mockA *Assertion, val string, pattern string, isMat
 		mockA.NotMatchNow(val, regPattern)
 	}, isMatch)
 }
+
+func TestTextEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testTextEqual(a, mockA, "", "", true)
+	testTextEqual(a, mockA, "Hello\nworld\n", "Hello\nworld\n", true)
+	testTextEqual(a, mockA, "Hello\nworld\n", "Hello\nthere\n", false)
+	testTextEqual(a, mockA, "Hello\nworld", "Hello\nworld\n", false)
+	testTextEqual(a, mockA, "Hello\r\nworld\n", "Hello\nworld\n", false)
+	testTextEqual(a, mockA, "Hello \nworld\n", "Hello\nworld\n", false)
+}
+
+func testTextEqual(a, mockA *Assertion, actual, expected string, isEqual bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "TextEqual", func() error {
+		return TextEqual(mockA.T, actual, expected)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion.TextEqual", func() error {
+		return mockA.TextEqual(actual, expected)
+	}, isEqual)
+	testAssertionNowFunction(a, "TextEqualNow", func() {
+		TextEqualNow(mockA.T, actual, expected)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion.TextEqualNow", func() {
+		mockA.TextEqualNow(actual, expected)
+	}, !isEqual)
+}
+
+func TestTextEqualMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.TextEqual("Hello\r\nworld\n", "Hello\nworld\n")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect texts equal:
+
+--- expected
++++ actual
+@@ -1,2 +1,2 @@
+1   -Hello
+  1 +Hello␍
+2 2  world`)
+}
+
+func TestEqualFold(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testNormalizedEqual(a, mockA, "EqualFold", "", "", true)
+	testNormalizedEqual(a, mockA, "EqualFold", "Hello World", "hello world", true)
+	testNormalizedEqual(a, mockA, "EqualFold", "Hello World", "HELLO WORLD", true)
+	testNormalizedEqual(a, mockA, "EqualFold", "K", "k", true) // Kelvin sign
+	testNormalizedEqual(a, mockA, "EqualFold", "Hello World", "Hello there", false)
+	testNormalizedEqual(a, mockA, "EqualFold", "Hello World", "HelloWorld", false)
+}
+
+func TestEqualIgnoringWhitespace(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	name := "EqualIgnoringWhitespace"
+	testNormalizedEqual(a, mockA, name, "", " \n\t", true)
+	testNormalizedEqual(a, mockA, name, "SELECT *\n  FROM users", "SELECT * FROM users", true)
+	testNormalizedEqual(a, mockA, name, " Hello\tworld\r\n", "Hello world", true)
+	testNormalizedEqual(a, mockA, name, "Helloworld", "Hello world", false)
+	testNormalizedEqual(a, mockA, name, "hello world", "Hello world", false)
+}
+
+func TestEqualNormalizedNewlines(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	name := "EqualNormalizedNewlines"
+	testNormalizedEqual(a, mockA, name, "Hello\r\nworld\r\n", "Hello\nworld\n", true)
+	testNormalizedEqual(a, mockA, name, "Hello\rworld\n", "Hello\r\nworld\r\n", true)
+	testNormalizedEqual(a, mockA, name, "Hello\r\nworld", "Hello\nworld\n", false)
+	testNormalizedEqual(a, mockA, name, "Hello\n\rworld", "Hello\nworld", false)
+}
+
+func TestEqualIgnoringIndentation(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	name := "EqualIgnoringIndentation"
+	testNormalizedEqual(a, mockA, name, "<p>\n  Hi\n</p>", "<p>\nHi\n</p>", true)
+	testNormalizedEqual(a, mockA, name, "\t<p>\n\t\tHi\n\t</p>\n", "<p>\n    Hi\n</p>\n", true)
+	testNormalizedEqual(a, mockA, name, "<p>\n  Hi\n</p>", "<p>Hi</p>", false)
+	testNormalizedEqual(a, mockA, name, "<p>\nHi  \n</p>", "<p>\nHi\n</p>", false)
+}
+
+func testNormalizedEqual(a, mockA *Assertion, name, actual, expected string, isEqual bool) {
+	a.Helper()
+
+	fn, fnNow, method, methodNow := getNormalizedEqualFunctions(mockA, name)
+
+	testAssertionFunction(a, name, func() error {
+		return fn(mockA.T, actual, expected)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion."+name, func() error {
+		return method(actual, expected)
+	}, isEqual)
+	testAssertionNowFunction(a, name+"Now", func() {
+		fnNow(mockA.T, actual, expected)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion."+name+"Now", func() {
+		methodNow(actual, expected)
+	}, !isEqual)
+}
+
+func getNormalizedEqualFunctions(mockA *Assertion, name string) (
+	func(*testing.T, string, string, ...any) error,
+	func(*testing.T, string, string, ...any) error,
+	func(string, string, ...any) error,
+	func(string, string, ...any) error,
+) {
+	switch name {
+	case "EqualFold":
+		return EqualFold, EqualFoldNow, mockA.EqualFold, mockA.EqualFoldNow
+	case "EqualIgnoringWhitespace":
+		return EqualIgnoringWhitespace, EqualIgnoringWhitespaceNow,
+			mockA.EqualIgnoringWhitespace, mockA.EqualIgnoringWhitespaceNow
+	case "EqualNormalizedNewlines":
+		return EqualNormalizedNewlines, EqualNormalizedNewlinesNow,
+			mockA.EqualNormalizedNewlines, mockA.EqualNormalizedNewlinesNow
+	case "EqualIgnoringIndentation":
+		return EqualIgnoringIndentation, EqualIgnoringIndentationNow,
+			mockA.EqualIgnoringIndentation, mockA.EqualIgnoringIndentationNow
+	case "ContainsStringFold":
+		return ContainsStringFold, ContainsStringFoldNow,
+			mockA.ContainsStringFold, mockA.ContainsStringFoldNow
+	case "HasPrefixStringFold":
+		return HasPrefixStringFold, HasPrefixStringFoldNow,
+			mockA.HasPrefixStringFold, mockA.HasPrefixStringFoldNow
+	case "HasSuffixStringFold":
+		return HasSuffixStringFold, HasSuffixStringFoldNow,
+			mockA.HasSuffixStringFold, mockA.HasSuffixStringFoldNow
+	default:
+		panic("unknown function " + name)
+	}
+}
+
+func TestStringFold(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testNormalizedEqual(a, mockA, "ContainsStringFold", "Hello World", "", true)
+	testNormalizedEqual(a, mockA, "ContainsStringFold", "Hello World", "o w", true)
+	testNormalizedEqual(a, mockA, "ContainsStringFold", "Hello World", "WORLD", true)
+	testNormalizedEqual(a, mockA, "ContainsStringFold", "Hello World", "there", false)
+
+	testNormalizedEqual(a, mockA, "HasPrefixStringFold", "Hello World", "", true)
+	testNormalizedEqual(a, mockA, "HasPrefixStringFold", "Hello World", "HELLO", true)
+	testNormalizedEqual(a, mockA, "HasPrefixStringFold", "Hello World", "world", false)
+
+	testNormalizedEqual(a, mockA, "HasSuffixStringFold", "Hello World", "", true)
+	testNormalizedEqual(a, mockA, "HasSuffixStringFold", "Hello World", "wORLD", true)
+	testNormalizedEqual(a, mockA, "HasSuffixStringFold", "Hello World", "hello", false)
+}
+
+func TestNormalizedEqualMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.EqualFold("Hello World", "HELLO THERE")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect strings equal ignoring case, compared:
+
+expected: "hello there"
+actual:   "hello world"`)
+
+	err = mockA.EqualIgnoringWhitespace("SELECT *\n  FROM users", "SELECT * FROM user")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect strings equal ignoring whitespaces, compared:
+
+expected: "SELECT * FROM user"
+actual:   "SELECT * FROM users"`)
+
+	err = mockA.EqualIgnoringIndentation("<p>\n  Hi\n</p>", "<p>\n\tHello\n</p>")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect strings equal ignoring indentation, compared:
+
+--- expected
++++ actual
+@@ -1,3 +1,3 @@
+1 1  <p>
+2   -Hello
+  2 +Hi
+3 3  </p>`)
+
+	err = mockA.ContainsStringFold("Hello World", "THERE")
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect contains "there" ignoring case, got "hello world"`)
+}
+
+func TestContainsAllAnyNoneStrings(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	str := "Hello world"
+
+	testContainsStrings(a, mockA, str, nil, true, false, true)
+	testContainsStrings(a, mockA, str, []string{"Hello", "world"}, true, true, false)
+	testContainsStrings(a, mockA, str, []string{"Hello", "there"}, false, true, false)
+	testContainsStrings(a, mockA, str, []string{"there", "hi"}, false, false, true)
+	testContainsStrings(a, mockA, "", []string{""}, true, true, false)
+}
+
+func testContainsStrings(
+	a, mockA *Assertion,
+	str string,
+	substrs []string,
+	isAll, isAny, isNone bool,
+) {
+	a.Helper()
+
+	// ContainsAllStrings
+	testAssertionFunction(a, "ContainsAllStrings", func() error {
+		return ContainsAllStrings(mockA.T, str, substrs)
+	}, isAll)
+	testAssertionFunction(a, "Assertion.ContainsAllStrings", func() error {
+		return mockA.ContainsAllStrings(str, substrs)
+	}, isAll)
+	testAssertionNowFunction(a, "ContainsAllStringsNow", func() {
+		ContainsAllStringsNow(mockA.T, str, substrs)
+	}, !isAll)
+	testAssertionNowFunction(a, "Assertion.ContainsAllStringsNow", func() {
+		mockA.ContainsAllStringsNow(str, substrs)
+	}, !isAll)
+
+	// ContainsAnyString
+	testAssertionFunction(a, "ContainsAnyString", func() error {
+		return ContainsAnyString(mockA.T, str, substrs)
+	}, isAny)
+	testAssertionFunction(a, "Assertion.ContainsAnyString", func() error {
+		return mockA.ContainsAnyString(str, substrs)
+	}, isAny)
+	testAssertionNowFunction(a, "ContainsAnyStringNow", func() {
+		ContainsAnyStringNow(mockA.T, str, substrs)
+	}, !isAny)
+	testAssertionNowFunction(a, "Assertion.ContainsAnyStringNow", func() {
+		mockA.ContainsAnyStringNow(str, substrs)
+	}, !isAny)
+
+	// ContainsNoneStrings
+	testAssertionFunction(a, "ContainsNoneStrings", func() error {
+		return ContainsNoneStrings(mockA.T, str, substrs)
+	}, isNone)
+	testAssertionFunction(a, "Assertion.ContainsNoneStrings", func() error {
+		return mockA.ContainsNoneStrings(str, substrs)
+	}, isNone)
+	testAssertionNowFunction(a, "ContainsNoneStringsNow", func() {
+		ContainsNoneStringsNow(mockA.T, str, substrs)
+	}, !isNone)
+	testAssertionNowFunction(a, "Assertion.ContainsNoneStringsNow", func() {
+		mockA.ContainsNoneStringsNow(str, substrs)
+	}, !isNone)
+}
+
+func TestStringCount(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testStringCount(a, mockA, "cheese", "e", 3, true)
+	testStringCount(a, mockA, "cheese", "e", 2, false)
+	testStringCount(a, mockA, "aaaa", "aa", 2, true)
+	testStringCount(a, mockA, "cheese", "x", 0, true)
+	testStringCount(a, mockA, "five", "", 5, true)
+}
+
+func testStringCount(a, mockA *Assertion, str, substr string, n int, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "StringCount", func() error {
+		return StringCount(mockA.T, str, substr, n)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.StringCount", func() error {
+		return mockA.StringCount(str, substr, n)
+	}, isOk)
+	testAssertionNowFunction(a, "StringCountNow", func() {
+		StringCountNow(mockA.T, str, substr, n)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.StringCountNow", func() {
+		mockA.StringCountNow(str, substr, n)
+	}, !isOk)
+}
+
+func TestContainsInOrder(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	str := "step 1, step 2, step 3"
+
+	testContainsInOrder(a, mockA, str, nil, true)
+	testContainsInOrder(a, mockA, str, []string{"1", "2", "3"}, true)
+	testContainsInOrder(a, mockA, str, []string{"step", "step", "step"}, true)
+	testContainsInOrder(a, mockA, str, []string{"step", "step", "step", "step"}, false)
+	testContainsInOrder(a, mockA, str, []string{"1", "3", "2"}, false)
+	testContainsInOrder(a, mockA, str, []string{"0", "1"}, false)
+	testContainsInOrder(a, mockA, "abc", []string{"ab", "bc"}, false)
+}
+
+func testContainsInOrder(a, mockA *Assertion, str string, parts []string, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "ContainsInOrder", func() error {
+		return ContainsInOrder(mockA.T, str, parts)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.ContainsInOrder", func() error {
+		return mockA.ContainsInOrder(str, parts)
+	}, isOk)
+	testAssertionNowFunction(a, "ContainsInOrderNow", func() {
+		ContainsInOrderNow(mockA.T, str, parts)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ContainsInOrderNow", func() {
+		mockA.ContainsInOrderNow(str, parts)
+	}, !isOk)
+}
+
+func TestMultiSubstringMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	str := "step 1, step 2, step 3"
+
+	err := mockA.ContainsAllStrings(str, []string{"1", "4", "5"})
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		`assert error: expect contains all of ["1" "4" "5"], missing ["4" "5"]`,
+	)
+
+	err = mockA.ContainsNoneStrings(str, []string{"2", "4", "step"})
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		`assert error: expect contains none of ["2" "4" "step"], found "2" at offset 13, "step" at offset 0`,
+	)
+
+	err = mockA.StringCount(str, "step", 2)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect 2 occurrence(s) of "step", got 3`)
+
+	err = mockA.ContainsInOrder(str, []string{"4"})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `assert error: expect contains ["4"] in order, "4" not found`)
+
+	err = mockA.ContainsInOrder(str, []string{"1", "3", "2"})
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		`assert error: expect contains ["1" "3" "2"] in order, "2" found at offset 13 out of order, `+
+			`the last match "3" ends at offset 22`,
+	)
+
+	err = mockA.ContainsInOrder(str, []string{"1", "4"})
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		`assert error: expect contains ["1" "4"] in order, "4" not found, `+
+			`the last match "1" ends at offset 6`,
+	)
+}
+
+func TestFullMatch(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testFullMatch(a, mockA, "hello", `[a-z]+`, true)
+	testFullMatch(a, mockA, "hello world", `[a-z]+`, false)
+	testFullMatch(a, mockA, "ab", `a|ab`, true)
+	testFullMatch(a, mockA, "a\nb", `(?m)^a$`, false)
+	testFullMatch(a, mockA, "", `a*`, true)
+	testFullMatch(a, mockA, "a\nb", `(?s)a.b`, true)
+	testFullMatch(a, mockA, "ABC", `(?i)abc`, true)
+
+	a.PanicNow(func() {
+		mockA.FullMatchString("hello", `[a-z`)
+	})
+	// the pattern is invalid even if it's valid after being anchored.
+	a.PanicNow(func() {
+		mockA.FullMatchString("ab", `a)(?:b`)
+	})
+}
+
+func testFullMatch(a, mockA *Assertion, val, pattern string, isMatch bool) {
+	a.Helper()
+
+	regPattern := regexp.MustCompile(pattern)
+
+	testAssertionFunction(a, "FullMatch", func() error {
+		return FullMatch(mockA.T, val, regPattern)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.FullMatch", func() error {
+		return mockA.FullMatch(val, regPattern)
+	}, isMatch)
+	testAssertionNowFunction(a, "FullMatchNow", func() {
+		FullMatchNow(mockA.T, val, regPattern)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.FullMatchNow", func() {
+		mockA.FullMatchNow(val, regPattern)
+	}, !isMatch)
+
+	testAssertionFunction(a, "FullMatchString", func() error {
+		return FullMatchString(mockA.T, val, pattern)
+	}, isMatch)
+	testAssertionFunction(a, "Assertion.FullMatchString", func() error {
+		return mockA.FullMatchString(val, pattern)
+	}, isMatch)
+	testAssertionNowFunction(a, "FullMatchStringNow", func() {
+		FullMatchStringNow(mockA.T, val, pattern)
+	}, !isMatch)
+	testAssertionNowFunction(a, "Assertion.FullMatchStringNow", func() {
+		mockA.FullMatchStringNow(val, pattern)
+	}, !isMatch)
+}
+
+func TestMatchCount(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testMatchCount(a, mockA, "1, 22, 333", `\d+`, 3, true)
+	testMatchCount(a, mockA, "1, 22, 333", `\d+`, 2, false)
+	testMatchCount(a, mockA, "1, 22, 333", `\d`, 6, true)
+	testMatchCount(a, mockA, "hello", `\d+`, 0, true)
+}
+
+func testMatchCount(a, mockA *Assertion, val, pattern string, n int, isOk bool) {
+	a.Helper()
+
+	regPattern := regexp.MustCompile(pattern)
+
+	testAssertionFunction(a, "MatchCount", func() error {
+		return MatchCount(mockA.T, val, regPattern, n)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.MatchCount", func() error {
+		return mockA.MatchCount(val, regPattern, n)
+	}, isOk)
+	testAssertionNowFunction(a, "MatchCountNow", func() {
+		MatchCountNow(mockA.T, val, regPattern, n)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.MatchCountNow", func() {
+		mockA.MatchCountNow(val, regPattern, n)
+	}, !isOk)
+}
+
+func TestMatchGroups(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	pattern := regexp.MustCompile(`(?P<year>\d{4})-(\d{2})(?:-(?P<day>\d{2}))?`)
+
+	testMatchGroups(a, mockA, "2024-05", pattern, nil, true)
+	testMatchGroups(a, mockA, "2024-05", pattern, map[string]string{"year": "2024"}, true)
+	testMatchGroups(a, mockA, "2024-05", pattern, map[string]string{
+		"0":    "2024-05",
+		"year": "2024",
+		"2":    "05",
+		"day":  "",
+	}, true)
+	testMatchGroups(a, mockA, "2024-05", pattern, map[string]string{"year": "2023"}, false)
+	testMatchGroups(a, mockA, "2024-05", pattern, map[string]string{"month": "05"}, false)
+	testMatchGroups(a, mockA, "2024-05", pattern, map[string]string{"4": ""}, false)
+	testMatchGroups(a, mockA, "May 2024", pattern, nil, false)
+
+	groups, err := mockA.MatchGroups("date: 2024-05-01", pattern, nil)
+	a.NilNow(err)
+	a.DeepEqualNow(groups, []string{"2024-05-01", "2024", "05", "01"})
+
+	groups, err = mockA.MatchGroups("May 2024", pattern, nil)
+	a.NotNilNow(err)
+	a.NilNow(groups)
+}
+
+func testMatchGroups(
+	a, mockA *Assertion,
+	val string,
+	pattern *regexp.Regexp,
+	expected map[string]string,
+	isOk bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "MatchGroups", func() error {
+		_, err := MatchGroups(mockA.T, val, pattern, expected)
+		return err
+	}, isOk)
+	testAssertionFunction(a, "Assertion.MatchGroups", func() error {
+		_, err := mockA.MatchGroups(val, pattern, expected)
+		return err
+	}, isOk)
+	testAssertionNowFunction(a, "MatchGroupsNow", func() {
+		MatchGroupsNow(mockA.T, val, pattern, expected)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.MatchGroupsNow", func() {
+		mockA.MatchGroupsNow(val, pattern, expected)
+	}, !isOk)
+}
+
+func TestRegexpMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.MatchString("example.com", `^https?://`)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect \"example.com\" matches pattern `^https?://`")
+
+	err = mockA.NotMatchString("http://example.com", `^https?://`)
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect \"http://example.com\" does not match pattern `^https?://`",
+	)
+
+	err = mockA.FullMatchString("hello world", `[a-z]+`)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect \"hello world\" fully matches pattern `[a-z]+`")
+
+	err = mockA.MatchCount("1, 22, 333", regexp.MustCompile(`\d+`), 2)
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect 2 match(es) of pattern `\\d+` in \"1, 22, 333\", got 3",
+	)
+
+	_, err = mockA.MatchGroups(
+		"2024-05",
+		regexp.MustCompile(`(?P<year>\d{4})-(\d{2})`),
+		map[string]string{"year": "2023", "2": "05", "day": "01"},
+	)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect capture groups of \"2024-05\" by pattern "+
+		"`(?P<year>\\d{4})-(\\d{2})` equal:\n"+
+		"  day: no such group\n"+
+		"  year: expect \"2023\", got \"2024\"")
+}
+
+func TestGetRegexp(t *testing.T) {
+	a := New(t)
+
+	re := getRegexp(`^[a-z]+$`)
+	a.EqualNow(re.String(), `^[a-z]+$`)
+	a.TrueNow(getRegexp(`^[a-z]+$`) == re)
+
+	a.PanicNow(func() {
+		getRegexp(`[a-z`)
+	})
+
+	for i := 0; i < maxRegexpCacheSize*2; i++ {
+		getRegexp(fmt.Sprintf(`^item-%d$`, i))
+	}
+	regexpCacheMu.RLock()
+	size := len(regexpCache)
+	regexpCacheMu.RUnlock()
+	a.LteNow(size, maxRegexpCacheSize)
+}
+
+func TestFullMatchCache(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	pattern := fmt.Sprintf(`a|ab%d`, maxRegexpCacheSize)
+	a.NilNow(mockA.FullMatchString(fmt.Sprintf("ab%d", maxRegexpCacheSize), pattern))
+
+	regexpCacheMu.RLock()
+	_, isCached := regexpCache[pattern]
+	_, isAnchoredCached := regexpCache[`^(?:`+pattern+`)$`]
+	regexpCacheMu.RUnlock()
+	a.TrueNow(isCached)
+	a.TrueNow(isAnchoredCached)
+
+	// does not change the matching semantics of the given regular expression
+	re := regexp.MustCompile(`a|ab`)
+	a.NilNow(mockA.FullMatch("ab", re))
+	a.EqualNow(re.FindString("ab"), "a")
+}
@@ -0,0 +1,348 @@
+package assert
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeEqual tests whether the times represent the same time instant or not, and it set the
+// result to fail if they are not the same instant. Unlike Equal, it ignores the location and the
+// monotonic clock reading of the times.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.TimeEqual(now, now.UTC()) // success
+//	a.TimeEqual(now, now.Add(time.Second)) // fail
+func (a *Assertion) TimeEqual(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryTimeEqual(a.testingT(), false, actual, expect, message...)
+}
+
+// TimeEqualNow tests whether the times represent the same time instant or not, and it will
+// terminate the execution if they are not the same instant.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.TimeEqualNow(now, now.UTC()) // success
+//	a.TimeEqualNow(now, now.Add(time.Second)) // fail and terminate
+//	// never runs
+func (a *Assertion) TimeEqualNow(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryTimeEqual(a.testingT(), true, actual, expect, message...)
+}
+
+// WithinDuration tests whether the difference between the times is not greater than the
+// duration, and it set the result to fail if the difference is greater than the duration.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.WithinDuration(now, now.Add(time.Second), time.Second) // success
+//	a.WithinDuration(now, now.Add(-time.Second), time.Second) // success
+//	a.WithinDuration(now, now.Add(time.Minute), time.Second) // fail
+func (a *Assertion) WithinDuration(
+	actual, expect time.Time,
+	d time.Duration,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryWithinDuration(a.testingT(), false, actual, expect, d, message...)
+}
+
+// WithinDurationNow tests whether the difference between the times is not greater than the
+// duration, and it will terminate the execution if the difference is greater than the duration.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.WithinDurationNow(now, now.Add(time.Second), time.Second) // success
+//	a.WithinDurationNow(now, now.Add(time.Minute), time.Second) // fail and terminate
+//	// never runs
+func (a *Assertion) WithinDurationNow(
+	actual, expect time.Time,
+	d time.Duration,
+	message ...any,
+) error {
+	a.Helper()
+
+	return tryWithinDuration(a.testingT(), true, actual, expect, d, message...)
+}
+
+// Before tests whether the actual time is before the expected time, and it set the result to
+// fail if the actual time is not before the expected time.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.Before(now, now.Add(time.Second)) // success
+//	a.Before(now, now) // fail
+func (a *Assertion) Before(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryBefore(a.testingT(), false, actual, expect, message...)
+}
+
+// BeforeNow tests whether the actual time is before the expected time, and it will terminate the
+// execution if the actual time is not before the expected time.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.BeforeNow(now, now.Add(time.Second)) // success
+//	a.BeforeNow(now, now) // fail and terminate
+//	// never runs
+func (a *Assertion) BeforeNow(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryBefore(a.testingT(), true, actual, expect, message...)
+}
+
+// After tests whether the actual time is after the expected time, and it set the result to fail
+// if the actual time is not after the expected time.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.After(now, now.Add(-time.Second)) // success
+//	a.After(now, now) // fail
+func (a *Assertion) After(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryAfter(a.testingT(), false, actual, expect, message...)
+}
+
+// AfterNow tests whether the actual time is after the expected time, and it will terminate the
+// execution if the actual time is not after the expected time.
+//
+//	a := assert.New(t)
+//	now := time.Now()
+//	a.AfterNow(now, now.Add(-time.Second)) // success
+//	a.AfterNow(now, now) // fail and terminate
+//	// never runs
+func (a *Assertion) AfterNow(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return tryAfter(a.testingT(), true, actual, expect, message...)
+}
+
+// SameDay tests whether the times are in the same day, and it set the result to fail if they are
+// not in the same day. The actual time will be converted to the location of the expected time
+// before comparing.
+//
+//	a := assert.New(t)
+//	a.SameDay(
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC),
+//	) // success
+//	a.SameDay(
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+//	) // fail
+func (a *Assertion) SameDay(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return trySameDay(a.testingT(), false, actual, expect, message...)
+}
+
+// SameDayNow tests whether the times are in the same day, and it will terminate the execution if
+// they are not in the same day. The actual time will be converted to the location of the expected
+// time before comparing.
+//
+//	a := assert.New(t)
+//	a.SameDayNow(
+//	  time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+//	  time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC),
+//	) // fail and terminate
+//	// never runs
+func (a *Assertion) SameDayNow(actual, expect time.Time, message ...any) error {
+	a.Helper()
+
+	return trySameDay(a.testingT(), true, actual, expect, message...)
+}
+
+// TimeInLocation tests whether the time is in the specified location, and it set the result to
+// fail if the location of the time is not the specified location.
+//
+//	a := assert.New(t)
+//	a.TimeInLocation(time.Now().UTC(), time.UTC) // success
+//	a.TimeInLocation(time.Now().In(time.Local), time.UTC) // fail if the local time zone is not UTC
+func (a *Assertion) TimeInLocation(tm time.Time, loc *time.Location, message ...any) error {
+	a.Helper()
+
+	return tryTimeInLocation(a.testingT(), false, tm, loc, message...)
+}
+
+// TimeInLocationNow tests whether the time is in the specified location, and it will terminate
+// the execution if the location of the time is not the specified location.
+//
+//	a := assert.New(t)
+//	a.TimeInLocationNow(time.Now().UTC(), time.UTC) // success
+//	a.TimeInLocationNow(time.Now().In(time.Local), time.UTC) // fail and terminate
+//	// never runs
+func (a *Assertion) TimeInLocationNow(tm time.Time, loc *time.Location, message ...any) error {
+	a.Helper()
+
+	return tryTimeInLocation(a.testingT(), true, tm, loc, message...)
+}
+
+// DurationWithin tests whether the difference between the durations is not greater than the
+// delta, and it set the result to fail if the difference is greater than the delta.
+//
+//	a := assert.New(t)
+//	a.DurationWithin(time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	a.DurationWithin(time.Second, 800*time.Millisecond, 100*time.Millisecond) // fail
+func (a *Assertion) DurationWithin(actual, expect, delta time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryDurationWithin(a.testingT(), false, actual, expect, delta, message...)
+}
+
+// DurationWithinNow tests whether the difference between the durations is not greater than the
+// delta, and it will terminate the execution if the difference is greater than the delta.
+//
+//	a := assert.New(t)
+//	a.DurationWithinNow(time.Second, 900*time.Millisecond, 100*time.Millisecond) // success
+//	a.DurationWithinNow(time.Second, 800*time.Millisecond, 100*time.Millisecond) // fail and terminate
+//	// never runs
+func (a *Assertion) DurationWithinNow(actual, expect, delta time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryDurationWithin(a.testingT(), true, actual, expect, delta, message...)
+}
+
+// tryTimeEqual tries to test whether the times are the same instant, and it'll fail if they are
+// not the same instant.
+func tryTimeEqual(t testingT, failedNow bool, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return actual.Equal(expect) },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageTimeEqual,
+			formatValue(actual), formatValue(expect), actual.Sub(expect),
+		),
+		message...,
+	)
+}
+
+// tryWithinDuration tries to test whether the difference between the times is not greater than
+// the duration, and it'll fail if the difference is greater than the duration.
+func tryWithinDuration(
+	t testingT,
+	failedNow bool,
+	actual, expect time.Time,
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	diff := actual.Sub(expect)
+
+	return test(
+		t,
+		func() bool { return diff >= -d && diff <= d },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageWithinDuration,
+			formatValue(actual), d, formatValue(expect), diff,
+		),
+		message...,
+	)
+}
+
+// tryBefore tries to test whether the actual time is before the expected time, and it'll fail if
+// the actual time is not before the expected time.
+func tryBefore(t testingT, failedNow bool, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return actual.Before(expect) },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageBefore,
+			formatValue(actual), formatValue(expect), actual.Sub(expect),
+		),
+		message...,
+	)
+}
+
+// tryAfter tries to test whether the actual time is after the expected time, and it'll fail if
+// the actual time is not after the expected time.
+func tryAfter(t testingT, failedNow bool, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return actual.After(expect) },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageAfter,
+			formatValue(actual), formatValue(expect), actual.Sub(expect),
+		),
+		message...,
+	)
+}
+
+// trySameDay tries to test whether the times are in the same day, and it'll fail if they are not
+// in the same day.
+func trySameDay(t testingT, failedNow bool, actual, expect time.Time, message ...any) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return isSameDay(actual, expect) },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageSameDay, formatValue(actual), formatValue(expect)),
+		message...,
+	)
+}
+
+// tryTimeInLocation tries to test whether the time is in the specified location, and it'll fail
+// if the time is not in the location.
+func tryTimeInLocation(
+	t testingT,
+	failedNow bool,
+	tm time.Time,
+	loc *time.Location,
+	message ...any,
+) error {
+	t.Helper()
+
+	return test(
+		t,
+		func() bool { return loc != nil && tm.Location().String() == loc.String() },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageTimeInLocation, formatValue(tm), loc, tm.Location()),
+		message...,
+	)
+}
+
+// tryDurationWithin tries to test whether the difference between the durations is not greater
+// than the delta, and it'll fail if the difference is greater than the delta.
+func tryDurationWithin(
+	t testingT,
+	failedNow bool,
+	actual, expect, delta time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	ok, diff := isInDelta(actual, expect, delta)
+
+	return test(
+		t,
+		func() bool { return ok },
+		failedNow,
+		fmt.Sprintf(defaultErrMessageDurationWithin, actual, delta, expect, diff),
+		message...,
+	)
+}
+
+// isSameDay checks whether the times are in the same day in the location of the second time.
+func isSameDay(t1, t2 time.Time) bool {
+	y1, m1, d1 := t1.In(t2.Location()).Date()
+	y2, m2, d2 := t2.Date()
+
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
@@ -0,0 +1,228 @@
+package assert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeEqual(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	now := time.Now()
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	testTimeEqual(a, mockA, now, now, true)
+	testTimeEqual(a, mockA, now, now.UTC(), true)
+	testTimeEqual(a, mockA, now, now.In(loc), true)
+	testTimeEqual(a, mockA, now, now.Round(0), true)
+	testTimeEqual(a, mockA, now, now.Add(time.Nanosecond), false)
+
+	err := New(new(testing.T)).TimeEqual(
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 0, 0, 1, 500, time.UTC),
+	)
+	a.EqualNow(
+		err.Error(),
+		"assert error: 2024-01-01T00:00:00Z != 2024-01-01T00:00:01.0000005Z, the difference is -1.0000005s",
+	)
+}
+
+func testTimeEqual(a, mockA *Assertion, actual, expect time.Time, isEqual bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "TimeEqual", func() error {
+		return TimeEqual(mockA.T, actual, expect)
+	}, isEqual)
+	testAssertionFunction(a, "Assertion.TimeEqual", func() error {
+		return mockA.TimeEqual(actual, expect)
+	}, isEqual)
+	testAssertionNowFunction(a, "TimeEqualNow", func() {
+		TimeEqualNow(mockA.T, actual, expect)
+	}, !isEqual)
+	testAssertionNowFunction(a, "Assertion.TimeEqualNow", func() {
+		mockA.TimeEqualNow(actual, expect)
+	}, !isEqual)
+}
+
+func TestWithinDuration(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	now := time.Now()
+
+	testWithinDuration(a, mockA, now, now, 0, true)
+	testWithinDuration(a, mockA, now, now.Add(time.Second), time.Second, true)
+	testWithinDuration(a, mockA, now, now.Add(-time.Second), time.Second, true)
+	testWithinDuration(a, mockA, now, now.Add(time.Second+1), time.Second, false)
+	testWithinDuration(a, mockA, now, now.Add(-time.Minute), time.Second, false)
+}
+
+func testWithinDuration(a, mockA *Assertion, actual, expect time.Time, d time.Duration, isWithin bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "WithinDuration", func() error {
+		return WithinDuration(mockA.T, actual, expect, d)
+	}, isWithin)
+	testAssertionFunction(a, "Assertion.WithinDuration", func() error {
+		return mockA.WithinDuration(actual, expect, d)
+	}, isWithin)
+	testAssertionNowFunction(a, "WithinDurationNow", func() {
+		WithinDurationNow(mockA.T, actual, expect, d)
+	}, !isWithin)
+	testAssertionNowFunction(a, "Assertion.WithinDurationNow", func() {
+		mockA.WithinDurationNow(actual, expect, d)
+	}, !isWithin)
+}
+
+func TestBeforeAndAfter(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	now := time.Now()
+
+	testBeforeAndAfter(a, mockA, now, now.Add(time.Second), true, false)
+	testBeforeAndAfter(a, mockA, now, now.Add(-time.Second), false, true)
+	testBeforeAndAfter(a, mockA, now, now, false, false)
+	testBeforeAndAfter(a, mockA, now, now.UTC(), false, false)
+}
+
+func testBeforeAndAfter(a, mockA *Assertion, actual, expect time.Time, isBefore, isAfter bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "Before", func() error {
+		return Before(mockA.T, actual, expect)
+	}, isBefore)
+	testAssertionFunction(a, "Assertion.Before", func() error {
+		return mockA.Before(actual, expect)
+	}, isBefore)
+	testAssertionNowFunction(a, "BeforeNow", func() {
+		BeforeNow(mockA.T, actual, expect)
+	}, !isBefore)
+	testAssertionNowFunction(a, "Assertion.BeforeNow", func() {
+		mockA.BeforeNow(actual, expect)
+	}, !isBefore)
+
+	testAssertionFunction(a, "After", func() error {
+		return After(mockA.T, actual, expect)
+	}, isAfter)
+	testAssertionFunction(a, "Assertion.After", func() error {
+		return mockA.After(actual, expect)
+	}, isAfter)
+	testAssertionNowFunction(a, "AfterNow", func() {
+		AfterNow(mockA.T, actual, expect)
+	}, !isAfter)
+	testAssertionNowFunction(a, "Assertion.AfterNow", func() {
+		mockA.AfterNow(actual, expect)
+	}, !isAfter)
+}
+
+func TestSameDay(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	testSameDay(a, mockA,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 23, 59, 59, 0, time.UTC),
+		true,
+	)
+	testSameDay(a, mockA,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+		false,
+	)
+	testSameDay(a, mockA,
+		time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		false,
+	)
+	testSameDay(a, mockA,
+		time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 2, 6, 0, 0, 0, loc),
+		true,
+	)
+	testSameDay(a, mockA,
+		time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 1, 20, 0, 0, 0, loc),
+		false,
+	)
+}
+
+func testSameDay(a, mockA *Assertion, actual, expect time.Time, isSameDay bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "SameDay", func() error {
+		return SameDay(mockA.T, actual, expect)
+	}, isSameDay)
+	testAssertionFunction(a, "Assertion.SameDay", func() error {
+		return mockA.SameDay(actual, expect)
+	}, isSameDay)
+	testAssertionNowFunction(a, "SameDayNow", func() {
+		SameDayNow(mockA.T, actual, expect)
+	}, !isSameDay)
+	testAssertionNowFunction(a, "Assertion.SameDayNow", func() {
+		mockA.SameDayNow(actual, expect)
+	}, !isSameDay)
+}
+
+func TestTimeInLocation(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	now := time.Now()
+	loc := time.FixedZone("UTC+8", 8*60*60)
+
+	testTimeInLocation(a, mockA, now.UTC(), time.UTC, true)
+	testTimeInLocation(a, mockA, now.In(loc), loc, true)
+	testTimeInLocation(a, mockA, now.In(loc), time.UTC, false)
+	testTimeInLocation(a, mockA, now.UTC(), nil, false)
+}
+
+func testTimeInLocation(a, mockA *Assertion, tm time.Time, loc *time.Location, isInLocation bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "TimeInLocation", func() error {
+		return TimeInLocation(mockA.T, tm, loc)
+	}, isInLocation)
+	testAssertionFunction(a, "Assertion.TimeInLocation", func() error {
+		return mockA.TimeInLocation(tm, loc)
+	}, isInLocation)
+	testAssertionNowFunction(a, "TimeInLocationNow", func() {
+		TimeInLocationNow(mockA.T, tm, loc)
+	}, !isInLocation)
+	testAssertionNowFunction(a, "Assertion.TimeInLocationNow", func() {
+		mockA.TimeInLocationNow(tm, loc)
+	}, !isInLocation)
+}
+
+func TestDurationWithin(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testDurationWithin(a, mockA, time.Second, time.Second, 0, true)
+	testDurationWithin(a, mockA, time.Second, 900*time.Millisecond, 100*time.Millisecond, true)
+	testDurationWithin(a, mockA, time.Second, 1100*time.Millisecond, 100*time.Millisecond, true)
+	testDurationWithin(a, mockA, time.Second, 800*time.Millisecond, 100*time.Millisecond, false)
+
+	err := New(new(testing.T)).DurationWithin(time.Second, 800*time.Millisecond, 100*time.Millisecond)
+	a.EqualNow(err.Error(), "assert error: expect 1s within 100ms of 800ms, the difference is 200ms")
+}
+
+func testDurationWithin(a, mockA *Assertion, actual, expect, delta time.Duration, isWithin bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "DurationWithin", func() error {
+		return DurationWithin(mockA.T, actual, expect, delta)
+	}, isWithin)
+	testAssertionFunction(a, "Assertion.DurationWithin", func() error {
+		return mockA.DurationWithin(actual, expect, delta)
+	}, isWithin)
+	testAssertionNowFunction(a, "DurationWithinNow", func() {
+		DurationWithinNow(mockA.T, actual, expect, delta)
+	}, !isWithin)
+	testAssertionNowFunction(a, "Assertion.DurationWithinNow", func() {
+		mockA.DurationWithinNow(actual, expect, delta)
+	}, !isWithin)
+}
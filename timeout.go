@@ -0,0 +1,183 @@
+package assert
+
+import (
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// functionResult is the result of running a function with a timeout.
+type functionResult struct {
+	// isReturned indicates whether the function returned or panicked before the timeout.
+	isReturned bool
+	// elapsed is the duration of the function call, it'll be the timeout if the function did not
+	// return in time.
+	elapsed time.Duration
+	// panicValue is the value recovered from the panic of the function.
+	panicValue any
+	// panicStack is the stack of the goroutine when the function panicked.
+	panicStack []byte
+}
+
+// CompletesWithin runs the function fn in a new goroutine, and tests whether it returns within the
+// duration. It'll set the result to fail with the stacks of all the goroutines if the function is
+// still running after the duration, and it'll also fail if the function panics.
+//
+//	a := assert.New(t)
+//	a.CompletesWithin(func() {
+//	  time.Sleep(10 * time.Millisecond)
+//	}, time.Second) // success
+//	a.CompletesWithin(func() {
+//	  select {}
+//	}, time.Second) // fail
+func (a *Assertion) CompletesWithin(fn func(), d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryCompletesWithin(a.testingT(), false, fn, d, message...)
+}
+
+// CompletesWithinNow runs the function fn in a new goroutine, and tests whether it returns within
+// the duration. It'll terminate the execution if the function is still running after the duration
+// or the function panics.
+//
+//	a := assert.New(t)
+//	a.CompletesWithinNow(func() {
+//	  time.Sleep(10 * time.Millisecond)
+//	}, time.Second) // success
+//	a.CompletesWithinNow(func() {
+//	  select {}
+//	}, time.Second) // fail and terminate
+//	// never runs
+func (a *Assertion) CompletesWithinNow(fn func(), d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryCompletesWithin(a.testingT(), true, fn, d, message...)
+}
+
+// Blocks runs the function fn in a new goroutine, and tests whether it is still running after the
+// duration. It'll set the result to fail if the function returns or panics within the duration.
+// The function will be kept running in the background if it blocks.
+//
+//	a := assert.New(t)
+//	ch := make(chan int)
+//	a.Blocks(func() {
+//	  <-ch
+//	}, 10*time.Millisecond) // success
+//	a.Blocks(func() {
+//	  // no blocking
+//	}, 10*time.Millisecond) // fail
+func (a *Assertion) Blocks(fn func(), d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryBlocks(a.testingT(), false, fn, d, message...)
+}
+
+// BlocksNow runs the function fn in a new goroutine, and tests whether it is still running after
+// the duration. It'll terminate the execution if the function returns or panics within the
+// duration.
+//
+//	a := assert.New(t)
+//	ch := make(chan int)
+//	a.BlocksNow(func() {
+//	  <-ch
+//	}, 10*time.Millisecond) // success
+//	a.BlocksNow(func() {
+//	  // no blocking
+//	}, 10*time.Millisecond) // fail and terminate
+//	// never runs
+func (a *Assertion) BlocksNow(fn func(), d time.Duration, message ...any) error {
+	a.Helper()
+
+	return tryBlocks(a.testingT(), true, fn, d, message...)
+}
+
+// tryCompletesWithin tries to run the function with the timeout, and it'll fail if the function
+// does not return in time or it panics.
+func tryCompletesWithin(
+	t testingT,
+	failedNow bool,
+	fn func(),
+	d time.Duration,
+	message ...any,
+) error {
+	t.Helper()
+
+	result := runWithTimeout(fn, d)
+
+	defaultMessage := ""
+	if !result.isReturned {
+		defaultMessage = fmt.Sprintf(defaultErrMessageCompletesWithin, d, dumpGoroutineStacks())
+	} else if result.panicValue != nil {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageUnexpectedPanic,
+			formatPanicValue(result.panicValue), result.panicStack,
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return result.isReturned && result.panicValue == nil },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryBlocks tries to run the function with the timeout, and it'll fail if the function returns or
+// panics in time.
+func tryBlocks(t testingT, failedNow bool, fn func(), d time.Duration, message ...any) error {
+	t.Helper()
+
+	result := runWithTimeout(fn, d)
+
+	defaultMessage := ""
+	if result.panicValue != nil {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageUnexpectedPanic,
+			formatPanicValue(result.panicValue), result.panicStack,
+		)
+	} else if result.isReturned {
+		defaultMessage = fmt.Sprintf(defaultErrMessageBlocks, d, result.elapsed)
+	}
+
+	return test(
+		t,
+		func() bool { return !result.isReturned },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// runWithTimeout runs the function in a new goroutine, and waits for it to return until the
+// timeout. The panic of the function will be recovered and set to the result.
+func runWithTimeout(fn func(), timeout time.Duration) functionResult {
+	start := time.Now()
+	done := make(chan functionResult, 1)
+
+	go func() {
+		result := functionResult{isReturned: true}
+
+		defer func() {
+			if e := recover(); e != nil {
+				result.panicValue = e
+				result.panicStack = debug.Stack()
+			}
+			result.elapsed = time.Since(start)
+
+			done <- result
+		}()
+
+		fn()
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-done:
+		return result
+	case <-timer.C:
+		return functionResult{elapsed: timeout}
+	}
+}
@@ -0,0 +1,96 @@
+package assert
+
+import (
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCompletesWithinAndBlocks(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	d := 50 * time.Millisecond
+
+	testCompletesWithinAndBlocks(a, mockA, func() {}, d, true, false)
+	testCompletesWithinAndBlocks(a, mockA, func() {
+		time.Sleep(time.Millisecond)
+	}, d, true, false)
+	testCompletesWithinAndBlocks(a, mockA, func() {
+		<-stop
+	}, d, false, true)
+	testCompletesWithinAndBlocks(a, mockA, func() {
+		panic("unexpected panic")
+	}, d, false, false)
+	testCompletesWithinAndBlocks(a, mockA, func() {
+		runtime.Goexit()
+	}, d, true, false)
+}
+
+func testCompletesWithinAndBlocks(
+	a, mockA *Assertion,
+	fn func(),
+	d time.Duration,
+	isCompleted, isBlocked bool,
+) {
+	a.Helper()
+
+	testAssertionFunction(a, "CompletesWithin", func() error {
+		return CompletesWithin(mockA.T, fn, d)
+	}, isCompleted)
+	testAssertionFunction(a, "Assertion.CompletesWithin", func() error {
+		return mockA.CompletesWithin(fn, d)
+	}, isCompleted)
+	testAssertionNowFunction(a, "CompletesWithinNow", func() {
+		CompletesWithinNow(mockA.T, fn, d)
+	}, !isCompleted)
+	testAssertionNowFunction(a, "Assertion.CompletesWithinNow", func() {
+		mockA.CompletesWithinNow(fn, d)
+	}, !isCompleted)
+
+	testAssertionFunction(a, "Blocks", func() error {
+		return Blocks(mockA.T, fn, d)
+	}, isBlocked)
+	testAssertionFunction(a, "Assertion.Blocks", func() error {
+		return mockA.Blocks(fn, d)
+	}, isBlocked)
+	testAssertionNowFunction(a, "BlocksNow", func() {
+		BlocksNow(mockA.T, fn, d)
+	}, !isBlocked)
+	testAssertionNowFunction(a, "Assertion.BlocksNow", func() {
+		mockA.BlocksNow(fn, d)
+	}, !isBlocked)
+}
+
+func TestCompletesWithinMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	stop := make(chan struct{})
+	defer close(stop)
+
+	err := mockA.CompletesWithin(func() {
+		testLeakedWorker(stop)
+	}, 10*time.Millisecond)
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), "expect function completes within 10ms"), err.Error())
+	a.TrueNow(strings.Contains(err.Error(), "testLeakedWorker"), err.Error())
+
+	err = mockA.CompletesWithin(func() {
+		panic("some panic")
+	}, 10*time.Millisecond)
+	a.NotNilNow(err)
+	a.TrueNow(strings.Contains(err.Error(), "got unexpected panic: \"some panic\""), err.Error())
+	a.TrueNow(strings.Contains(err.Error(), "TestCompletesWithinMessage"), err.Error())
+
+	err = mockA.Blocks(func() {}, 10*time.Millisecond)
+	a.NotNilNow(err)
+	a.TrueNow(strings.HasPrefix(
+		err.Error(),
+		"assert error: expect function blocks for 10ms, it returned after",
+	), err.Error())
+}
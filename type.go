@@ -0,0 +1,358 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// IsType tests whether the dynamic type of the value is the same as the type of the expected
+// sample, or the type if the sample is a `reflect.Type`. It'll set the result to fail with the
+// full names of the types, including the package paths, if the types are not the same.
+//
+//	a := assert.New(t)
+//	a.IsType(1, 0) // success
+//	a.IsType(&bytes.Buffer{}, (*bytes.Buffer)(nil)) // success
+//	a.IsType(1, int64(0)) // fail
+func (a *Assertion) IsType(v, expectedSample any, message ...any) error {
+	a.Helper()
+
+	return tryIsType(a.testingT(), false, v, expectedSample, message...)
+}
+
+// IsTypeNow tests whether the dynamic type of the value is the same as the type of the expected
+// sample, or the type if the sample is a `reflect.Type`. It'll terminate the execution if the
+// types are not the same.
+//
+//	a := assert.New(t)
+//	a.IsTypeNow(1, 0) // success
+//	a.IsTypeNow(1, int64(0)) // fail and terminate
+//	// never runs
+func (a *Assertion) IsTypeNow(v, expectedSample any, message ...any) error {
+	a.Helper()
+
+	return tryIsType(a.testingT(), true, v, expectedSample, message...)
+}
+
+// IsKind tests whether the kind of the value is the expected kind, and it set the result to fail
+// if the kind is not the expected kind. The kind of nil is `reflect.Invalid`.
+//
+//	a := assert.New(t)
+//	a.IsKind([]int{1}, reflect.Slice) // success
+//	a.IsKind(map[string]int{}, reflect.Slice) // fail
+func (a *Assertion) IsKind(v any, kind reflect.Kind, message ...any) error {
+	a.Helper()
+
+	return tryIsKind(a.testingT(), false, v, kind, message...)
+}
+
+// IsKindNow tests whether the kind of the value is the expected kind, and it will terminate the
+// execution if the kind is not the expected kind.
+//
+//	a := assert.New(t)
+//	a.IsKindNow([]int{1}, reflect.Slice) // success
+//	a.IsKindNow(map[string]int{}, reflect.Slice) // fail and terminate
+//	// never runs
+func (a *Assertion) IsKindNow(v any, kind reflect.Kind, message ...any) error {
+	a.Helper()
+
+	return tryIsKind(a.testingT(), true, v, kind, message...)
+}
+
+// Implements tests whether the dynamic type of the value implements the interface, and the
+// interface is given by a nil pointer to it like `(*io.Reader)(nil)`. It'll set the result to fail
+// with the missing methods if the value does not implement the interface, and it'll panic if the
+// iface is not a pointer to an interface.
+//
+//	a := assert.New(t)
+//	a.Implements(&bytes.Buffer{}, (*io.Reader)(nil)) // success
+//	a.Implements(bytes.Buffer{}, (*io.Reader)(nil)) // fail
+func (a *Assertion) Implements(v, iface any, message ...any) error {
+	a.Helper()
+
+	return tryImplements(a.testingT(), false, v, iface, message...)
+}
+
+// ImplementsNow tests whether the dynamic type of the value implements the interface, and the
+// interface is given by a nil pointer to it like `(*io.Reader)(nil)`. It'll terminate the
+// execution if the value does not implement the interface, and it'll panic if the iface is not a
+// pointer to an interface.
+//
+//	a := assert.New(t)
+//	a.ImplementsNow(&bytes.Buffer{}, (*io.Reader)(nil)) // success
+//	a.ImplementsNow(bytes.Buffer{}, (*io.Reader)(nil)) // fail and terminate
+//	// never runs
+func (a *Assertion) ImplementsNow(v, iface any, message ...any) error {
+	a.Helper()
+
+	return tryImplements(a.testingT(), true, v, iface, message...)
+}
+
+// AssignableTo tests whether the value is assignable to the type of the sample, or the type if the
+// sample is a `reflect.Type`. It'll set the result to fail if the value is not assignable to the
+// type.
+//
+//	a := assert.New(t)
+//	a.AssignableTo(&bytes.Buffer{}, reflect.TypeOf((*io.Writer)(nil)).Elem()) // success
+//	a.AssignableTo(1, int64(0)) // fail
+func (a *Assertion) AssignableTo(v, sample any, message ...any) error {
+	a.Helper()
+
+	return tryAssignableTo(a.testingT(), false, v, sample, message...)
+}
+
+// AssignableToNow tests whether the value is assignable to the type of the sample, or the type if
+// the sample is a `reflect.Type`. It'll terminate the execution if the value is not assignable to
+// the type.
+//
+//	a := assert.New(t)
+//	a.AssignableToNow(&bytes.Buffer{}, reflect.TypeOf((*io.Writer)(nil)).Elem()) // success
+//	a.AssignableToNow(1, int64(0)) // fail and terminate
+//	// never runs
+func (a *Assertion) AssignableToNow(v, sample any, message ...any) error {
+	a.Helper()
+
+	return tryAssignableTo(a.testingT(), true, v, sample, message...)
+}
+
+// ConvertibleTo tests whether the value is convertible to the type of the sample, or the type if
+// the sample is a `reflect.Type`. It'll set the result to fail if the value is not convertible to
+// the type.
+//
+//	a := assert.New(t)
+//	a.ConvertibleTo(1, int64(0)) // success
+//	a.ConvertibleTo("1", 0) // fail
+func (a *Assertion) ConvertibleTo(v, sample any, message ...any) error {
+	a.Helper()
+
+	return tryConvertibleTo(a.testingT(), false, v, sample, message...)
+}
+
+// ConvertibleToNow tests whether the value is convertible to the type of the sample, or the type
+// if the sample is a `reflect.Type`. It'll terminate the execution if the value is not
+// convertible to the type.
+//
+//	a := assert.New(t)
+//	a.ConvertibleToNow(1, int64(0)) // success
+//	a.ConvertibleToNow("1", 0) // fail and terminate
+//	// never runs
+func (a *Assertion) ConvertibleToNow(v, sample any, message ...any) error {
+	a.Helper()
+
+	return tryConvertibleTo(a.testingT(), true, v, sample, message...)
+}
+
+// tryIsType tries to test whether the type of the value is the type of the expected sample, and
+// it'll fail if the types are not the same.
+func tryIsType(t testingT, failedNow bool, v, expectedSample any, message ...any) error {
+	t.Helper()
+
+	actualType := reflect.TypeOf(v)
+	expectedType := getSampleType(expectedSample)
+
+	return test(
+		t,
+		func() bool { return actualType == expectedType },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageIsType, getFullTypeName(expectedType), getFullTypeName(actualType),
+		),
+		message...,
+	)
+}
+
+// tryIsKind tries to test whether the kind of the value is the expected kind, and it'll fail if
+// the kind is not the expected kind.
+func tryIsKind(t testingT, failedNow bool, v any, kind reflect.Kind, message ...any) error {
+	t.Helper()
+
+	actualKind := reflect.ValueOf(v).Kind()
+
+	return test(
+		t,
+		func() bool { return actualKind == kind },
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageIsKind, kind, actualKind, getFullTypeName(reflect.TypeOf(v)),
+		),
+		message...,
+	)
+}
+
+// tryImplements tries to test whether the type of the value implements the interface, and it'll
+// fail if the value does not implement the interface.
+func tryImplements(t testingT, failedNow bool, v, iface any, message ...any) error {
+	t.Helper()
+
+	ifaceType := reflect.TypeOf(iface)
+	if ifaceType == nil || ifaceType.Kind() != reflect.Pointer ||
+		ifaceType.Elem().Kind() != reflect.Interface {
+		panic(ErrNotInterface)
+	}
+	ifaceType = ifaceType.Elem()
+
+	actualType := reflect.TypeOf(v)
+	isImplements := actualType != nil && actualType.Implements(ifaceType)
+
+	defaultMessage := ""
+	if !isImplements {
+		defaultMessage = fmt.Sprintf(
+			defaultErrMessageImplements,
+			getFullTypeName(actualType),
+			getFullTypeName(ifaceType),
+			strings.Join(getMissingMethods(actualType, ifaceType), ", "),
+		)
+	}
+
+	return test(
+		t,
+		func() bool { return isImplements },
+		failedNow,
+		defaultMessage,
+		message...,
+	)
+}
+
+// tryAssignableTo tries to test whether the value is assignable to the type of the sample, and
+// it'll fail if the value is not assignable to the type.
+func tryAssignableTo(t testingT, failedNow bool, v, sample any, message ...any) error {
+	t.Helper()
+
+	actualType := reflect.TypeOf(v)
+	targetType := getSampleType(sample)
+
+	return test(
+		t,
+		func() bool {
+			return actualType != nil && targetType != nil && actualType.AssignableTo(targetType)
+		},
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageAssignableTo, getFullTypeName(actualType), getFullTypeName(targetType),
+		),
+		message...,
+	)
+}
+
+// tryConvertibleTo tries to test whether the value is convertible to the type of the sample, and
+// it'll fail if the value is not convertible to the type.
+func tryConvertibleTo(t testingT, failedNow bool, v, sample any, message ...any) error {
+	t.Helper()
+
+	actualType := reflect.TypeOf(v)
+	targetType := getSampleType(sample)
+
+	return test(
+		t,
+		func() bool {
+			return actualType != nil && targetType != nil && actualType.ConvertibleTo(targetType)
+		},
+		failedNow,
+		fmt.Sprintf(
+			defaultErrMessageConvertibleTo, getFullTypeName(actualType), getFullTypeName(targetType),
+		),
+		message...,
+	)
+}
+
+// getSampleType returns the type of the sample, or the sample itself if it is a `reflect.Type`.
+func getSampleType(sample any) reflect.Type {
+	if typ, ok := sample.(reflect.Type); ok {
+		return typ
+	}
+
+	return reflect.TypeOf(sample)
+}
+
+// getMissingMethods returns the names of the methods of the interface that the type does not
+// have, or has with the different signatures. The methods that only the pointer to the type has
+// will be marked.
+func getMissingMethods(typ, iface reflect.Type) []string {
+	missing := make([]string, 0)
+
+	for i := 0; i < iface.NumMethod(); i++ {
+		name := iface.Method(i).Name
+		if typ == nil {
+			missing = append(missing, name)
+			continue
+		}
+
+		hasReceiver := typ.Kind() != reflect.Interface
+		if method, ok := typ.MethodByName(name); ok {
+			if !isMethodTypeMatched(method.Type, iface.Method(i).Type, hasReceiver) {
+				missing = append(missing, name+" (wrong signature)")
+			}
+		} else if _, ok := reflect.PointerTo(typ).MethodByName(name); ok && hasReceiver {
+			missing = append(missing, name+" (pointer receiver)")
+		} else {
+			missing = append(missing, name)
+		}
+	}
+
+	return missing
+}
+
+// isMethodTypeMatched checks whether the method has the same signature as the method of the
+// interface, and the receiver of the method will be skipped if the method is not from an
+// interface.
+func isMethodTypeMatched(method, ifaceMethod reflect.Type, hasReceiver bool) bool {
+	offset := 0
+	if hasReceiver {
+		offset = 1
+	}
+
+	if method.NumIn()-offset != ifaceMethod.NumIn() || method.NumOut() != ifaceMethod.NumOut() ||
+		method.IsVariadic() != ifaceMethod.IsVariadic() {
+		return false
+	}
+	for i := 0; i < ifaceMethod.NumIn(); i++ {
+		if method.In(i+offset) != ifaceMethod.In(i) {
+			return false
+		}
+	}
+	for i := 0; i < ifaceMethod.NumOut(); i++ {
+		if method.Out(i) != ifaceMethod.Out(i) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getFullTypeName returns the name of the type with the full package paths of the named types,
+// like `*github.com/ghosind/go-assert.Assertion`.
+func getFullTypeName(typ reflect.Type) string {
+	if typ == nil {
+		return "<nil>"
+	}
+
+	if typ.Name() != "" {
+		if typ.PkgPath() == "" {
+			return typ.Name()
+		}
+		return typ.PkgPath() + "." + typ.Name()
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return "*" + getFullTypeName(typ.Elem())
+	case reflect.Slice:
+		return "[]" + getFullTypeName(typ.Elem())
+	case reflect.Array:
+		return "[" + strconv.Itoa(typ.Len()) + "]" + getFullTypeName(typ.Elem())
+	case reflect.Map:
+		return "map[" + getFullTypeName(typ.Key()) + "]" + getFullTypeName(typ.Elem())
+	case reflect.Chan:
+		switch typ.ChanDir() {
+		case reflect.RecvDir:
+			return "<-chan " + getFullTypeName(typ.Elem())
+		case reflect.SendDir:
+			return "chan<- " + getFullTypeName(typ.Elem())
+		default:
+			return "chan " + getFullTypeName(typ.Elem())
+		}
+	default:
+		return typ.String()
+	}
+}
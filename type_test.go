@@ -0,0 +1,216 @@
+package assert
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type testReadCloser struct{}
+
+func (r *testReadCloser) Read(p []byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (r testReadCloser) Close() string {
+	return ""
+}
+
+func TestIsType(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testIsType(a, mockA, 1, 0, true)
+	testIsType(a, mockA, 1, int64(0), false)
+	testIsType(a, mockA, &bytes.Buffer{}, (*bytes.Buffer)(nil), true)
+	testIsType(a, mockA, bytes.Buffer{}, (*bytes.Buffer)(nil), false)
+	testIsType(a, mockA, 1, reflect.TypeOf(0), true)
+	testIsType(a, mockA, nil, nil, true)
+	testIsType(a, mockA, nil, 0, false)
+}
+
+func testIsType(a, mockA *Assertion, v, sample any, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "IsType", func() error {
+		return IsType(mockA.T, v, sample)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.IsType", func() error {
+		return mockA.IsType(v, sample)
+	}, isOk)
+	testAssertionNowFunction(a, "IsTypeNow", func() {
+		IsTypeNow(mockA.T, v, sample)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.IsTypeNow", func() {
+		mockA.IsTypeNow(v, sample)
+	}, !isOk)
+}
+
+func TestIsKind(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testIsKind(a, mockA, []int{1}, reflect.Slice, true)
+	testIsKind(a, mockA, map[string]int{}, reflect.Slice, false)
+	testIsKind(a, mockA, &bytes.Buffer{}, reflect.Pointer, true)
+	testIsKind(a, mockA, nil, reflect.Invalid, true)
+	testIsKind(a, mockA, nil, reflect.Pointer, false)
+}
+
+func testIsKind(a, mockA *Assertion, v any, kind reflect.Kind, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "IsKind", func() error {
+		return IsKind(mockA.T, v, kind)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.IsKind", func() error {
+		return mockA.IsKind(v, kind)
+	}, isOk)
+	testAssertionNowFunction(a, "IsKindNow", func() {
+		IsKindNow(mockA.T, v, kind)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.IsKindNow", func() {
+		mockA.IsKindNow(v, kind)
+	}, !isOk)
+}
+
+func TestImplements(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	testImplements(a, mockA, &bytes.Buffer{}, (*io.Reader)(nil), true)
+	testImplements(a, mockA, bytes.Buffer{}, (*io.Reader)(nil), false)
+	testImplements(a, mockA, io.Reader(&bytes.Buffer{}), (*io.Writer)(nil), true)
+	testImplements(a, mockA, 1, (*io.Reader)(nil), false)
+	testImplements(a, mockA, nil, (*io.Reader)(nil), false)
+	testImplements(a, mockA, 1, (*any)(nil), true)
+
+	a.PanicNow(func() {
+		mockA.Implements(&bytes.Buffer{}, (*bytes.Buffer)(nil))
+	})
+	a.PanicNow(func() {
+		mockA.Implements(&bytes.Buffer{}, nil)
+	})
+}
+
+func testImplements(a, mockA *Assertion, v, iface any, isOk bool) {
+	a.Helper()
+
+	testAssertionFunction(a, "Implements", func() error {
+		return Implements(mockA.T, v, iface)
+	}, isOk)
+	testAssertionFunction(a, "Assertion.Implements", func() error {
+		return mockA.Implements(v, iface)
+	}, isOk)
+	testAssertionNowFunction(a, "ImplementsNow", func() {
+		ImplementsNow(mockA.T, v, iface)
+	}, !isOk)
+	testAssertionNowFunction(a, "Assertion.ImplementsNow", func() {
+		mockA.ImplementsNow(v, iface)
+	}, !isOk)
+}
+
+func TestAssignableToAndConvertibleTo(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	writerType := reflect.TypeOf((*io.Writer)(nil)).Elem()
+
+	testAssignableAndConvertible(a, mockA, &bytes.Buffer{}, writerType, true, true)
+	testAssignableAndConvertible(a, mockA, 1, int64(0), false, true)
+	testAssignableAndConvertible(a, mockA, 1, 0, true, true)
+	testAssignableAndConvertible(a, mockA, "1", 0, false, false)
+	testAssignableAndConvertible(a, mockA, "1", []byte{}, false, true)
+	testAssignableAndConvertible(a, mockA, nil, 0, false, false)
+}
+
+func testAssignableAndConvertible(
+	a, mockA *Assertion,
+	v, sample any,
+	isAssignable, isConvertible bool,
+) {
+	a.Helper()
+
+	// AssignableTo
+	testAssertionFunction(a, "AssignableTo", func() error {
+		return AssignableTo(mockA.T, v, sample)
+	}, isAssignable)
+	testAssertionFunction(a, "Assertion.AssignableTo", func() error {
+		return mockA.AssignableTo(v, sample)
+	}, isAssignable)
+	testAssertionNowFunction(a, "AssignableToNow", func() {
+		AssignableToNow(mockA.T, v, sample)
+	}, !isAssignable)
+	testAssertionNowFunction(a, "Assertion.AssignableToNow", func() {
+		mockA.AssignableToNow(v, sample)
+	}, !isAssignable)
+
+	// ConvertibleTo
+	testAssertionFunction(a, "ConvertibleTo", func() error {
+		return ConvertibleTo(mockA.T, v, sample)
+	}, isConvertible)
+	testAssertionFunction(a, "Assertion.ConvertibleTo", func() error {
+		return mockA.ConvertibleTo(v, sample)
+	}, isConvertible)
+	testAssertionNowFunction(a, "ConvertibleToNow", func() {
+		ConvertibleToNow(mockA.T, v, sample)
+	}, !isConvertible)
+	testAssertionNowFunction(a, "Assertion.ConvertibleToNow", func() {
+		mockA.ConvertibleToNow(v, sample)
+	}, !isConvertible)
+}
+
+func TestTypeMessage(t *testing.T) {
+	a := New(t)
+	mockA := New(new(testing.T))
+
+	err := mockA.IsType(bytes.Buffer{}, (*bytes.Buffer)(nil))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect type *bytes.Buffer, got bytes.Buffer")
+
+	err = mockA.IsType([]*Assertion{}, map[string]chan<- Assertion{})
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect type map[string]chan<- github.com/ghosind/go-assert.Assertion, "+
+			"got []*github.com/ghosind/go-assert.Assertion",
+	)
+
+	err = mockA.IsKind(map[string]int{}, reflect.Slice)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect kind slice, got map (type map[string]int)")
+
+	err = mockA.Implements(testReadCloser{}, (*io.ReadCloser)(nil))
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect type github.com/ghosind/go-assert.testReadCloser implements "+
+			"io.ReadCloser, missing methods: Close (wrong signature), Read (pointer receiver)",
+	)
+
+	err = mockA.Implements(io.Reader(&bytes.Buffer{}), (*io.ReadCloser)(nil))
+	a.NotNilNow(err)
+	a.EqualNow(
+		err.Error(),
+		"assert error: expect type *bytes.Buffer implements io.ReadCloser, missing methods: Close",
+	)
+
+	err = mockA.AssignableTo(1, int64(0))
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect type int assignable to int64")
+
+	err = mockA.ConvertibleTo("1", 0)
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: expect type string convertible to int")
+}
+
+func TestGetFullTypeName(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(getFullTypeName(nil), "<nil>")
+	a.EqualNow(getFullTypeName(reflect.TypeOf([2]<-chan int{})), "[2]<-chan int")
+	a.EqualNow(getFullTypeName(reflect.TypeOf(func() {})), "func()")
+	a.TrueNow(strings.HasSuffix(getFullTypeName(reflect.TypeOf(a)), "/go-assert.Assertion"))
+}
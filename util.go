@@ -1,17 +1,32 @@
 package assert
 
 import (
+	"errors"
+	"fmt"
+	"math/big"
 	"reflect"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 var (
 	floatType = reflect.TypeOf(float64(0))
 )
 
+// testingT is the methods of testing.T that the assertions use to report the failures. It's
+// implemented by testing.T, and the recorder of the assertion blocks.
+type testingT interface {
+	Helper()
+	Error(args ...any)
+	FailNow()
+}
+
 // test tries to run the test function, and creates an assertion error if the result is fail.
 func test(
-	t *testing.T,
+	t testingT,
 	fn func() bool,
 	failedNow bool,
 	defaultMessage string,
@@ -33,13 +48,27 @@ func test(
 // failed handles the assertion error with the specific testing.T or the assertion's t. It will set
 // marks the function has failed if the err is not nil. It'll also stops the execution if failedNow
 // set to true.
-func failed(t *testing.T, err error, failedNow bool) {
+//
+// In the goroutine-safe mode, the error from a goroutine other than the test goroutine will be
+// recorded and reported by the test goroutine later, and only the current goroutine will be
+// stopped if failedNow set to true.
+func failed(t testingT, err error, failedNow bool) {
 	t.Helper()
 
 	if err == nil {
 		return
 	}
 
+	if tt, ok := t.(*testing.T); ok {
+		if state := getWorkerGoroutineSafeState(tt); state != nil {
+			state.report(err, failedNow)
+			if failedNow {
+				runtime.Goexit()
+			}
+			return
+		}
+	}
+
 	t.Error(err)
 
 	if failedNow {
@@ -47,6 +76,77 @@ func failed(t *testing.T, err error, failedNow bool) {
 	}
 }
 
+// assertionBlockRecorder records the failures of the assertions in an assertion block instead of
+// reporting them to the test, and the XXXNow assertions only stop the execution of the block.
+type assertionBlockRecorder struct {
+	// t is the testing.T of the test that the assertion block runs in.
+	t *testing.T
+	// messages are the failure messages of the assertions in the block.
+	messages []string
+	// mu is the lock of the messages.
+	mu sync.Mutex
+}
+
+// Helper does nothing, the failures of the assertion block are reported by the caller.
+func (r *assertionBlockRecorder) Helper() {}
+
+// Error records the failure message of an assertion in the block.
+func (r *assertionBlockRecorder) Error(args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.messages = append(r.messages, fmt.Sprint(args...))
+}
+
+// FailNow stops the execution of the assertion block.
+func (r *assertionBlockRecorder) FailNow() {
+	runtime.Goexit()
+}
+
+// runAssertionBlock runs the assertion block in a new goroutine with an assertion that records the
+// failures in a local recorder, and returns nil if no assertion in the block failed, or an error
+// with the failure messages of the assertions in the block. The block will be treated as failed if
+// it panics, and the XXXNow assertions in the block only stop the execution of the block.
+func runAssertionBlock(t testingT, fn func(a *Assertion)) error {
+	recorder := &assertionBlockRecorder{t: getTestingT(t)}
+	a := &Assertion{T: recorder.t, recorder: recorder}
+	var panicValue any
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+		defer func() {
+			panicValue = recover()
+		}()
+
+		fn(a)
+	}()
+
+	wg.Wait()
+
+	messages := recorder.messages
+	if panicValue != nil {
+		messages = append(messages, "panic: "+formatPanicValue(panicValue))
+	}
+
+	if len(messages) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(messages, "\n"))
+}
+
+// getTestingT returns the testing.T of the test that the assertions run in.
+func getTestingT(t testingT) *testing.T {
+	if recorder, ok := t.(*assertionBlockRecorder); ok {
+		return recorder.t
+	}
+
+	return t.(*testing.T)
+}
+
 // ################################
 // ## Assertion Helper Functions ##
 // ################################
@@ -74,6 +174,41 @@ func isSameType(t1, t2 reflect.Type) bool {
 	}
 }
 
+// isComplex checks whether the value is a complex number or not, and it'll panic if the value is
+// nil.
+func isComplex(v any) bool {
+	typ := reflect.TypeOf(v)
+	if typ == nil {
+		panic(ErrNotFloat)
+	}
+
+	kind := typ.Kind()
+	return kind == reflect.Complex64 || kind == reflect.Complex128
+}
+
+// isSinglePrecision checks whether the value is a float32 or a complex64, and it'll panic if the
+// value is nil.
+func isSinglePrecision(v any) bool {
+	typ := reflect.TypeOf(v)
+	if typ == nil {
+		panic(ErrNotFloat)
+	}
+
+	kind := typ.Kind()
+	return kind == reflect.Float32 || kind == reflect.Complex64
+}
+
+// toComplex converts the value to a complex128, and it'll panic if the value is not a complex
+// number and can't be converted to a floating number.
+func toComplex(v any) complex128 {
+	vv := reflect.ValueOf(v)
+	if vv.CanComplex() {
+		return vv.Complex()
+	}
+
+	return complex(toFloat(v), 0)
+}
+
 // toFloat converts the value to a float64, and it'll panic if the value can't be converted.
 func toFloat(v any) float64 {
 	vv := reflect.ValueOf(v)
@@ -81,9 +216,29 @@ func toFloat(v any) float64 {
 		return vv.Float()
 	}
 
-	if vv.CanConvert(floatType) {
+	if vv.IsValid() && vv.CanConvert(floatType) {
 		return vv.Convert(floatType).Float()
 	}
 
 	panic(ErrNotFloat)
 }
+
+// formatValue formats the value in its natural form for the messages. For example, `time.Time`
+// values will be formatted in RFC3339 format with nanoseconds, and `*big.Rat` values will be
+// formatted as integers if their denominators are 1.
+func formatValue(v any) string {
+	switch vv := v.(type) {
+	case time.Time:
+		return vv.Format(time.RFC3339Nano)
+	case *big.Rat:
+		if vv != nil {
+			return vv.RatString()
+		}
+	case *big.Float:
+		if vv != nil {
+			return vv.Text('g', -1)
+		}
+	}
+
+	return fmt.Sprint(v)
+}
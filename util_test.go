@@ -1,7 +1,9 @@
 package assert
 
 import (
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ghosind/go-assert/internal"
 )
@@ -35,3 +37,65 @@ func TestToFloat(t *testing.T) {
 		toFloat("1.0")
 	})
 }
+
+func TestRunAssertionBlock(t *testing.T) {
+	a := New(t)
+	mockT := new(testing.T)
+
+	a.NilNow(runAssertionBlock(mockT, func(a *Assertion) {
+		a.True(true)
+	}))
+	a.NotNilNow(runAssertionBlock(mockT, func(a *Assertion) {
+		a.True(false)
+	}))
+	a.NotNilNow(runAssertionBlock(mockT, func(a *Assertion) {
+		a.TrueNow(false)
+		a.True(true)
+	}))
+	a.NotNilNow(runAssertionBlock(mockT, func(a *Assertion) {
+		panic("some panic")
+	}))
+
+	err := runAssertionBlock(mockT, func(a *Assertion) {
+		a.Equal(1, 2)
+		a.EqualNow(1, 3, "custom message")
+		a.True(false)
+	})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: 1 == 2\ncustom message")
+
+	err = runAssertionBlock(mockT, func(a *Assertion) {
+		panic("some panic")
+	})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), `panic: "some panic"`)
+
+	err = runAssertionBlock(mockT, func(a *Assertion) {
+		a.NilNow(runAssertionBlock(a.testingT(), func(a *Assertion) {
+			a.Equal(1, 1)
+		}))
+		a.NotNil(runAssertionBlock(a.testingT(), func(a *Assertion) {
+			a.Equal(1, 2)
+		}))
+		a.Equal(2, 3)
+	})
+	a.NotNilNow(err)
+	a.EqualNow(err.Error(), "assert error: 2 == 3")
+
+	// the failures in the assertion blocks never fail the test
+	a.NotTrueNow(mockT.Failed())
+}
+
+func TestFormatValue(t *testing.T) {
+	a := New(t)
+
+	a.EqualNow(formatValue(1), "1")
+	a.EqualNow(formatValue("Hello"), "Hello")
+	a.EqualNow(formatValue(time.Second), "1s")
+	a.EqualNow(formatValue(time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC)), "2024-01-02T03:04:05.000000006Z")
+	a.EqualNow(formatValue(big.NewInt(10)), "10")
+	a.EqualNow(formatValue(big.NewFloat(1.5)), "1.5")
+	a.EqualNow(formatValue(big.NewRat(4, 2)), "2")
+	a.EqualNow(formatValue(big.NewRat(1, 2)), "1/2")
+	a.EqualNow(formatValue((*big.Rat)(nil)), "<nil>")
+}